@@ -2,12 +2,18 @@ package account
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 )
 
 func New(client *http.Client) *Service {
@@ -16,6 +22,11 @@ func New(client *http.Client) *Service {
 	}
 	s := &Service{client: client, BasePath: basePath}
 	s.Me = NewMeService(s)
+	s.Friend = NewFriendService(s)
+	s.User = NewUserService(s)
+	s.Device = NewDeviceService(s)
+	s.Organization = NewOrganizationService(s)
+	s.Token = NewTokenService(s)
 	return s
 }
 
@@ -27,6 +38,10 @@ type Service struct {
 	Me     *MeService
 	Friend *FriendService
 	User   *UserService
+	Device *DeviceService
+
+	Organization *OrganizationService
+	Token        *TokenService
 
 	// Set to true to output debugging logs during API calls
 	Debug bool
@@ -48,6 +63,9 @@ func (c *Service) doRequest(method, path string, payload interface{}) (*http.Req
 		if err != nil {
 			return nil, err
 		}
+		if c.Debug {
+			logPayload(payload)
+		}
 	}
 
 	req, err := http.NewRequest(method, url, body)
@@ -62,6 +80,29 @@ func (c *Service) doRequest(method, path string, payload interface{}) (*http.Req
 	return req, nil
 }
 
+// A patch holds the fields set on an update call, keyed by their JSON
+// name, so that fields left alone are not sent and stay unchanged.
+type patch map[string]interface{}
+
+// A redacter is a request payload carrying credentials or secrets.
+// redacted returns a copy of it that is safe to write to debug logs.
+type redacter interface {
+	redacted() interface{}
+}
+
+const redactedValue = "[REDACTED]"
+
+func logPayload(payload interface{}) {
+	if r, ok := payload.(redacter); ok {
+		payload = r.redacted()
+	}
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+	log.Printf("Request payload: %s", b)
+}
+
 func (c *Service) get(path string, obj interface{}) (*http.Response, error) {
 	req, err := c.doRequest("GET", path, nil)
 	if err != nil {
@@ -71,6 +112,92 @@ func (c *Service) get(path string, obj interface{}) (*http.Response, error) {
 	return c.do(req, obj)
 }
 
+func (c *Service) getContext(ctx context.Context, path string, obj interface{}) (*http.Response, error) {
+	req, err := c.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req.WithContext(ctx), obj)
+}
+
+// download is like getContext but negotiates a non-JSON representation of
+// the resource; obj is usually an io.Writer or a *mediaTarget.
+func (c *Service) download(ctx context.Context, path, accept string, obj interface{}) (*http.Response, error) {
+	req, err := c.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+
+	return c.do(req.WithContext(ctx), obj)
+}
+
+// upload POSTs a multipart/form-data request holding the given form
+// fields and the content of r as the file part named field.
+func (c *Service) upload(ctx context.Context, path string, fields map[string]string, field, filename string, r io.Reader, obj interface{}) (*http.Response, error) {
+	body := new(bytes.Buffer)
+	mw := multipart.NewWriter(body)
+	for k, v := range fields {
+		if err := mw.WriteField(k, v); err != nil {
+			return nil, err
+		}
+	}
+	part, err := mw.CreateFormFile(field, filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return nil, err
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.BasePath+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.Header.Add("Accept", "application/json")
+
+	return c.do(req.WithContext(ctx), obj)
+}
+
+// postForm POSTs an application/x-www-form-urlencoded request, as OAuth
+// endpoints expect. The form is not written to debug logs.
+func (c *Service) postForm(ctx context.Context, path string, form url.Values, obj interface{}) (*http.Response, error) {
+	req, err := http.NewRequest("POST", c.BasePath+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Add("Accept", "application/json")
+
+	return c.do(req.WithContext(ctx), obj)
+}
+
+func (c *Service) getWithHeader(path string, header http.Header, obj interface{}) (*http.Response, error) {
+	req, err := c.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	return c.do(req, obj)
+}
+
+func (c *Service) head(path string) (*http.Response, error) {
+	req, err := c.doRequest("HEAD", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
 func (c *Service) post(path string, payload, obj interface{}) (*http.Response, error) {
 	req, err := c.doRequest("POST", path, payload)
 	if err != nil {
@@ -112,7 +239,9 @@ func (c *Service) delete(path string, payload interface{}, obj interface{}) (*ht
 // The API response is JSON decoded and stored in the value pointed by obj,
 // or returned as an error if an API error has occurred.
 // If obj implements the io.Writer interface, the raw response body will be written to obj,
-// without attempting to decode it.
+// without attempting to decode it. If obj is a *mediaTarget, the response
+// Content-Type decides between the two; if it is a *typedWriter, the
+// Content-Type must match before the body is written.
 func (c *Service) do(req *http.Request, obj interface{}) (*http.Response, error) {
 	if c.Debug {
 		log.Printf("Executing request (%v): %#v", req.URL, req)
@@ -135,17 +264,61 @@ func (c *Service) do(req *http.Request, obj interface{}) (*http.Response, error)
 
 	// If obj implements the io.Writer,
 	// the response body is decoded into v.
+	if t, ok := obj.(*mediaTarget); ok {
+		if isJSON(resp) {
+			obj = t.V
+		} else {
+			obj = t.W
+		}
+	}
+	if t, ok := obj.(*typedWriter); ok {
+		if ct := mediaType(resp); ct != t.ContentType {
+			return resp, fmt.Errorf("account: unexpected Content-Type %q, want %q", ct, t.ContentType)
+		}
+		obj = t.W
+	}
 	if obj != nil {
 		if w, ok := obj.(io.Writer); ok {
-			io.Copy(w, resp.Body)
+			_, err = io.Copy(w, resp.Body)
 		} else {
 			err = json.NewDecoder(resp.Body).Decode(obj)
+			if err == io.EOF {
+				// 204 No Content and other empty bodies
+				err = nil
+			}
 		}
 	}
 
 	return resp, err
 }
 
+// A mediaTarget receives a response body that is either a JSON envelope,
+// decoded into V, or a raw payload, written to W.
+type mediaTarget struct {
+	W io.Writer
+	V interface{}
+}
+
+// A typedWriter receives a raw payload, written to W, that must be of the
+// given media type. Other responses fail without writing anything.
+type typedWriter struct {
+	W           io.Writer
+	ContentType string
+}
+
+// mediaType returns the media type of the response, without parameters.
+func mediaType(resp *http.Response) string {
+	ct := resp.Header.Get("Content-Type")
+	if i := strings.Index(ct, ";"); i >= 0 {
+		ct = ct[:i]
+	}
+	return strings.TrimSpace(ct)
+}
+
+func isJSON(resp *http.Response) bool {
+	return mediaType(resp) == "application/json"
+}
+
 type GetUserResponse struct {
 	Message string `json:"message"`
 	Code    int    `json:"code"`
@@ -155,24 +328,55 @@ type GetUserResponse struct {
 		DisplayName  string `json:"display_name"`
 		Subscribed   bool   `json:"subscribed"`
 		Language     string `json:"language"`
-		Gender       int    `json:"gender"`
+		Gender       Gender `json:"gender"`
 		CreatedAt    string `json:"created_at"`
 		UpdatedAt    string `json:"updated_at"`
 		PortalNotify bool   `json:"portal_notify"`
 		SimpleToken  string `json:"simple_token"`
-		Brithday     string `json:"brithday"`
+		Birthday     Date   `json:"brithday"` // sic, as spelled by the API
 		MobileNumber string `json:"mobile_number"`
 		UserId       string `json:"user_id"`
 		Email        string `json:"email"`
+		Timezone     string `json:"timezone"`
+		Country      string `json:"country"` // ISO 3166-1 alpha-2
+
+		// QID is the short account identifier used by the device APIs.
+		QID      string `json:"qid"`
+		Username string `json:"username"`
+
+		// Identifiers holds the alternate identifiers of the account, by
+		// kind. It is only returned when requested with
+		// MeGetCall.IncludeIdentifiers, and nil otherwise.
+		Identifiers map[string]string `json:"identifiers"`
 	} `json:"result"`
 }
 
 type MeService struct {
 	s *Service
 
-	Activity *ActivityService
-	Password *PasswordService
-	Avatar   *AvatarService
+	Activity       *ActivityService
+	Password       *PasswordService
+	Avatar         *AvatarService
+	Sessions       *SessionsService
+	Email          *EmailService
+	Phone          *PhoneService
+	TwoFactor      *TwoFactorService
+	Notifications  *NotificationsService
+	SimpleToken    *SimpleTokenService
+	ConnectedApps  *ConnectedAppsService
+	DataExport     *DataExportService
+	SecurityEvents *SecurityEventsService
+	LinkedAccounts *LinkedAccountsService
+	LoginHistory   *LoginHistoryService
+	TrustedDevices *TrustedDevicesService
+	APIKeys        *APIKeysService
+	Privacy        *PrivacyService
+	Deletion       *DeletionService
+	Consents       *ConsentsService
+	Newsletter     *NewsletterService
+	Merge          *MergeService
+	PushTokens     *PushTokensService
+	Inbox          *InboxService
 }
 
 func NewMeService(s *Service) *MeService {
@@ -180,20 +384,50 @@ func NewMeService(s *Service) *MeService {
 	rs.Activity = NewActivityService(s)
 	rs.Password = NewPasswordService(s)
 	rs.Avatar = NewAvatarService(s)
+	rs.Sessions = NewSessionsService(s)
+	rs.Email = NewEmailService(s)
+	rs.Phone = NewPhoneService(s)
+	rs.TwoFactor = NewTwoFactorService(s)
+	rs.Notifications = NewNotificationsService(s)
+	rs.SimpleToken = NewSimpleTokenService(s)
+	rs.ConnectedApps = NewConnectedAppsService(s)
+	rs.DataExport = NewDataExportService(s)
+	rs.SecurityEvents = NewSecurityEventsService(s)
+	rs.LinkedAccounts = NewLinkedAccountsService(s)
+	rs.LoginHistory = NewLoginHistoryService(s)
+	rs.TrustedDevices = NewTrustedDevicesService(s)
+	rs.APIKeys = NewAPIKeysService(s)
+	rs.Privacy = NewPrivacyService(s)
+	rs.Deletion = NewDeletionService(s)
+	rs.Consents = NewConsentsService(s)
+	rs.Newsletter = NewNewsletterService(s)
+	rs.Merge = NewMergeService(s)
+	rs.PushTokens = NewPushTokensService(s)
+	rs.Inbox = NewInboxService(s)
 	return rs
 }
 
 type MeGetCall struct {
-	s *Service
+	s      *Service
+	params url.Values
 }
 
 func (r *MeService) Get() *MeGetCall {
-	c := &MeGetCall{s: r.s}
+	c := &MeGetCall{s: r.s, params: url.Values{}}
+	return c
+}
+
+// IncludeIdentifiers asks for the alternate identifiers of the account.
+func (c *MeGetCall) IncludeIdentifiers() *MeGetCall {
+	c.params.Set("include_identifiers", "true")
 	return c
 }
 
 func (c *MeGetCall) Do() (*GetUserResponse, error) {
 	path := versioned("me")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
 	ret := &GetUserResponse{}
 	_, err := c.s.get(path, &ret)
 	if err != nil {
@@ -202,13 +436,41 @@ func (c *MeGetCall) Do() (*GetUserResponse, error) {
 	return ret, nil
 }
 
-type ActivityService struct {
-	s *Service
+type SubscriptionResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Subscribed bool `json:"subscribed"`
+
+		// ConsentRecordedAt is when the current consent state was recorded.
+		// It does not move when the state is set to its current value.
+		ConsentRecordedAt time.Time `json:"consent_recorded_at"`
+	} `json:"result"`
 }
 
-func NewActivityService(s *Service) *ActivityService {
-	rs := &ActivityService{s: s}
-	return rs
+type MeSetSubscribedCall struct {
+	s          *Service
+	subscribed bool
+}
+
+// SetSubscribed records the newsletter consent of the account. Setting
+// the current value again succeeds without changing anything.
+func (r *MeService) SetSubscribed(subscribed bool) *MeSetSubscribedCall {
+	c := &MeSetSubscribedCall{s: r.s, subscribed: subscribed}
+	return c
+}
+
+func (c *MeSetSubscribedCall) Do() (*SubscriptionResponse, error) {
+	path := versioned("me/subscription")
+	payload := struct {
+		Subscribed bool `json:"subscribed"`
+	}{c.subscribed}
+	ret := &SubscriptionResponse{}
+	_, err := c.s.put(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
 }
 
 type PasswordService struct {
@@ -231,19 +493,53 @@ func NewAvatarService(s *Service) *AvatarService {
 
 type FriendService struct {
 	s *Service
+
+	Invitations *FriendInvitationsService
+	Blocked     *FriendBlockedService
+	Groups      *FriendGroupsService
 }
 
 func NewFriendService(s *Service) *FriendService {
 	rs := &FriendService{s: s}
+	rs.Invitations = NewFriendInvitationsService(s)
+	rs.Blocked = NewFriendBlockedService(s)
+	rs.Groups = NewFriendGroupsService(s)
 	return rs
 }
 
-type UserService struct {
+type DeviceService struct {
 	s *Service
 }
 
-func NewUserService(s *Service) *UserService {
-	rs := &UserService{s: s}
+func NewDeviceService(s *Service) *DeviceService {
+	rs := &DeviceService{s: s}
+	return rs
+}
+
+type OrganizationService struct {
+	s *Service
+
+	Members *OrganizationMembersService
+	Roles   *OrganizationRolesService
+}
+
+func NewOrganizationService(s *Service) *OrganizationService {
+	rs := &OrganizationService{s: s}
+	rs.Members = NewOrganizationMembersService(s)
+	rs.Roles = NewOrganizationRolesService(s)
+	return rs
+}
+
+// TokenService validates and revokes OAuth tokens, and runs the device
+// authorization flow: StartDeviceAuthorization, then PollForToken with
+// the ClientID and Interval setters. It works with a Service that is not
+// authenticated as a user.
+type TokenService struct {
+	s *Service
+}
+
+func NewTokenService(s *Service) *TokenService {
+	rs := &TokenService{s: s}
 	return rs
 }
 
@@ -254,6 +550,29 @@ type Response struct {
 	HttpResponse *http.Response
 }
 
+// ErrNotConfirmed is returned, before any request is sent, by destructive
+// calls that were not explicitly confirmed.
+var ErrNotConfirmed = errors.New("account: destructive call requires Confirm()")
+
+// ErrNotFound is matched by errors.Is for API errors caused by a missing resource.
+var ErrNotFound = errors.New("account: not found")
+
+// ErrForbidden is matched by errors.Is for API errors caused by the
+// account lacking permission on a resource.
+var ErrForbidden = errors.New("account: forbidden")
+
+// ErrNotModified is matched by errors.Is for 304 Not Modified responses
+// to conditional requests: the resource still has the given ETag.
+var ErrNotModified = errors.New("account: not modified")
+
+// ErrRateLimited is matched by errors.Is for 429 Too Many Requests
+// responses. The ErrorResponse carries the requested RetryAfter delay.
+var ErrRateLimited = errors.New("account: rate limited")
+
+// ErrUnauthorized is matched by errors.Is for 401 Unauthorized responses:
+// the credentials of the Service were missing or rejected.
+var ErrUnauthorized = errors.New("account: unauthorized")
+
 // An ErrorResponse represents an API response that generated an error.
 type ErrorResponse struct {
 	Response
@@ -261,6 +580,16 @@ type ErrorResponse struct {
 	// human-readable message
 	Message string `json:"message"`
 	Code    int    `json:"code"`
+
+	// Details holds the error specific payload of the envelope, if any.
+	Details json.RawMessage `json:"details"`
+
+	// OAuthError is the error code returned by the OAuth endpoints, which
+	// do not use the envelope, e.g. "authorization_pending".
+	OAuthError string `json:"error"`
+
+	// RetryAfter is the delay requested by the Retry-After header, if any.
+	RetryAfter time.Duration `json:"-"`
 }
 
 // Error implements the error interface.
@@ -270,6 +599,62 @@ func (r *ErrorResponse) Error() string {
 		r.HttpResponse.StatusCode, r.Message)
 }
 
+// Is reports whether the error matches one of the package sentinel errors.
+func (r *ErrorResponse) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return r.HttpResponse.StatusCode == http.StatusNotFound
+	case ErrForbidden:
+		return r.HttpResponse.StatusCode == http.StatusForbidden
+	case ErrNotModified:
+		return r.HttpResponse.StatusCode == http.StatusNotModified
+	case ErrRateLimited:
+		return r.HttpResponse.StatusCode == http.StatusTooManyRequests
+	case ErrUnauthorized:
+		return r.HttpResponse.StatusCode == http.StatusUnauthorized
+	}
+	if r.OAuthError != "" && oauthErrors[r.OAuthError] == target {
+		return true
+	}
+	return r.Code != 0 && apiErrors[r.Code] == target
+}
+
+// apiErrors maps the API error codes carried in the response envelope to
+// the sentinel errors they match.
+var apiErrors = map[int]error{
+	codeCurrentSession:   ErrCurrentSession,
+	codeEmailTaken:       ErrEmailTaken,
+	codeInvalidToken:     ErrInvalidToken,
+	codeWrongCode:        ErrWrongCode,
+	codeChallengeExpired: ErrChallengeExpired,
+	codeReauthRequired:   ErrReauthRequired,
+	codeExportExpired:    ErrExportExpired,
+	codeWouldLockOut:     ErrWouldLockOut,
+	codeUnknownTopic:     ErrUnknownTopic,
+	codeMergeBlocked:     ErrMergeBlocked,
+	codeAlreadyFriends:   ErrAlreadyFriends,
+	codeInvitePending:    ErrInvitationPending,
+	codeNotFriend:        ErrNotFriend,
+	codeResendCooldown:   ErrResendCooldown,
+
+	codeRegistrationCodeExpired: ErrRegistrationCodeExpired,
+	codeRegistrationCodeUsed:    ErrRegistrationCodeUsed,
+	codeDeviceLimit:             ErrDeviceLimit,
+	codeHostnameTaken:           ErrHostnameTaken,
+	codeCloudLinkExpired:        ErrCloudLinkExpired,
+	codeAlreadyHasAccess:        ErrAlreadyHasAccess,
+	codeTransferNotEligible:     ErrTransferNotEligible,
+	codeNoOrganization:          ErrNoOrganization,
+	codeLastOwner:               ErrLastOwner,
+}
+
+// oauthErrors maps the error codes of the OAuth endpoints to the sentinel
+// errors they match.
+var oauthErrors = map[string]error{
+	"expired_token": ErrDeviceCodeExpired,
+	"access_denied": ErrAccessDenied,
+}
+
 // CheckResponse checks the API response for errors, and returns them if present.
 // A response is considered an error if the status code is different than 2xx. Specific requests
 // may have additional requirements, but this is sufficient in most of the cases.
@@ -281,10 +666,27 @@ func CheckResponse(resp *http.Response) error {
 	errorResponse := &ErrorResponse{}
 	errorResponse.HttpResponse = resp
 
-	err := json.NewDecoder(resp.Body).Decode(errorResponse)
-	if err != nil {
-		return err
-	}
+	// The body is not guaranteed to be a JSON envelope (e.g. proxies or
+	// empty 404s), so decoding failures still yield an ErrorResponse.
+	json.NewDecoder(resp.Body).Decode(errorResponse)
+	errorResponse.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
 
 	return errorResponse
 }
+
+// parseRetryAfter parses a Retry-After header value, given either in
+// seconds or as an HTTP date.
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
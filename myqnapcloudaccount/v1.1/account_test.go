@@ -1,7 +1,12 @@
 package account
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"golang.org/x/net/context"
 	"golang.org/x/oauth2"
@@ -28,6 +33,14 @@ func (s *MySuite) SetUpTest(c *C) {
 
 var _ = Suite(&MySuite{})
 
+// newFakeService returns a Service whose requests are served by h.
+func newFakeService(h http.HandlerFunc) (*Service, *httptest.Server) {
+	ts := httptest.NewServer(h)
+	s := New(ts.Client())
+	s.BasePath = ts.URL
+	return s, ts
+}
+
 func (s *MySuite) Test_Myqnapcloud_Account_Me(chk *C) {
 	res, err := s.c.Me.Get().Do()
 	if err != nil {
@@ -36,3 +49,66 @@ func (s *MySuite) Test_Myqnapcloud_Account_Me(chk *C) {
 		chk.Log(res)
 	}
 }
+
+func (s *MySuite) Test_Myqnapcloud_Account_Me_Identifiers(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/me")
+		if r.URL.Query().Get("include_identifiers") != "true" {
+			chk.Check(r.URL.RawQuery, Equals, "")
+			fmt.Fprint(w, `{"code":0,"result":{"user_id":"5f1b7c1e-0c3a-4a8e-9d7f-2b6a1c0e9f11","qid":"Q1234567","username":"qeek"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"code":0,"result":{"user_id":"5f1b7c1e-0c3a-4a8e-9d7f-2b6a1c0e9f11","qid":"Q1234567","username":"qeek",
+			"identifiers":{"legacy_id":"100234","sso_subject":"qnap|100234"}}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Get().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.QID, Equals, "Q1234567")
+	chk.Check(res.Result.Username, Equals, "qeek")
+	chk.Check(res.Result.Identifiers, IsNil)
+
+	res, err = svc.Me.Get().IncludeIdentifiers().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.QID, Equals, "Q1234567")
+	chk.Check(res.Result.Identifiers, DeepEquals, map[string]string{"legacy_id": "100234", "sso_subject": "qnap|100234"})
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Me_SetSubscribed(chk *C) {
+	subscribed := false
+	recorded := "2017-01-01T00:00:00Z"
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "PUT")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/subscription")
+		var body struct {
+			Subscribed bool `json:"subscribed"`
+		}
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		if body.Subscribed != subscribed {
+			subscribed = body.Subscribed
+			recorded = "2017-02-23T08:00:00Z"
+			if !subscribed {
+				recorded = "2017-02-24T08:00:00Z"
+			}
+		}
+		fmt.Fprintf(w, `{"code":0,"result":{"subscribed":%t,"consent_recorded_at":%q}}`, subscribed, recorded)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.SetSubscribed(true).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Subscribed, Equals, true)
+	at := res.Result.ConsentRecordedAt
+	chk.Check(at.Equal(time.Date(2017, 2, 23, 8, 0, 0, 0, time.UTC)), Equals, true)
+
+	res, err = svc.Me.SetSubscribed(true).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Subscribed, Equals, true)
+	chk.Check(res.Result.ConsentRecordedAt.Equal(at), Equals, true)
+
+	res, err = svc.Me.SetSubscribed(false).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Subscribed, Equals, false)
+	chk.Check(res.Result.ConsentRecordedAt.After(at), Equals, true)
+}
@@ -0,0 +1,524 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ActivityType is the type code of an account activity entry.
+//
+// Codes unknown to this package are kept verbatim, so entries produced by
+// newer API versions survive a decode/encode round trip.
+type ActivityType string
+
+// Documented activity type codes.
+const (
+	ActivityLogin              ActivityType = "login"
+	ActivityLogout             ActivityType = "logout"
+	ActivityPasswordChange     ActivityType = "password_change"
+	ActivityPasswordReset      ActivityType = "password_reset"
+	ActivityProfileUpdate      ActivityType = "profile_update"
+	ActivityEmailChange        ActivityType = "email_change"
+	ActivityDeviceRegistered   ActivityType = "device_registered"
+	ActivityDeviceUnregistered ActivityType = "device_unregistered"
+	ActivityTwoFactorEnabled   ActivityType = "two_factor_enabled"
+	ActivityTwoFactorDisabled  ActivityType = "two_factor_disabled"
+)
+
+var knownActivityTypes = map[ActivityType]bool{
+	ActivityLogin:              true,
+	ActivityLogout:             true,
+	ActivityPasswordChange:     true,
+	ActivityPasswordReset:      true,
+	ActivityProfileUpdate:      true,
+	ActivityEmailChange:        true,
+	ActivityDeviceRegistered:   true,
+	ActivityDeviceUnregistered: true,
+	ActivityTwoFactorEnabled:   true,
+	ActivityTwoFactorDisabled:  true,
+}
+
+// ParseActivityType parses an activity type code. Surrounding spaces and
+// letter case are ignored. Unknown codes are returned as is; use IsKnown to
+// tell them apart.
+func ParseActivityType(s string) (ActivityType, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return "", errors.New("account: empty activity type")
+	}
+	return ActivityType(s), nil
+}
+
+// IsKnown reports whether t is one of the documented activity type codes.
+func (t ActivityType) IsKnown() bool {
+	return knownActivityTypes[t]
+}
+
+// An Activity is a single entry of the account activity log.
+type Activity struct {
+	ID        string       `json:"id"`
+	Type      ActivityType `json:"type"`
+	Success   bool         `json:"success"`
+	IP        string       `json:"ip"`
+	Location  string       `json:"location"`
+	Device    string       `json:"device"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// An ActivityDetail is the full form of an activity entry.
+type ActivityDetail struct {
+	Activity
+
+	UserAgent string       `json:"user_agent"`
+	Geo       *Coordinates `json:"geo"`
+}
+
+// Coordinates is a geographic position in decimal degrees.
+type Coordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type ActivityGetResponse struct {
+	Message string         `json:"message"`
+	Code    int            `json:"code"`
+	Result  ActivityDetail `json:"result"`
+}
+
+type ActivityListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Activities    []*Activity `json:"activities"`
+		NextPageToken string      `json:"next_page_token"`
+	} `json:"result"`
+}
+
+type ActivityService struct {
+	s *Service
+}
+
+func NewActivityService(s *Service) *ActivityService {
+	rs := &ActivityService{s: s}
+	return rs
+}
+
+type ActivityListCall struct {
+	s      *Service
+	params url.Values
+
+	after, before time.Time
+}
+
+// List lists the activity log of the current account, most recent first.
+func (r *ActivityService) List() *ActivityListCall {
+	c := &ActivityListCall{s: r.s, params: url.Values{}}
+	return c
+}
+
+// PageSize sets the maximum number of entries returned per page.
+func (c *ActivityListCall) PageSize(n int) *ActivityListCall {
+	c.params.Set("page_size", strconv.Itoa(n))
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *ActivityListCall) PageToken(token string) *ActivityListCall {
+	c.params.Set("page_token", token)
+	return c
+}
+
+// CreatedAfter restricts the results to entries created at or after t.
+func (c *ActivityListCall) CreatedAfter(t time.Time) *ActivityListCall {
+	c.after = t
+	c.params.Set("created_after", t.UTC().Format(time.RFC3339))
+	return c
+}
+
+// CreatedBefore restricts the results to entries created before t.
+func (c *ActivityListCall) CreatedBefore(t time.Time) *ActivityListCall {
+	c.before = t
+	c.params.Set("created_before", t.UTC().Format(time.RFC3339))
+	return c
+}
+
+// Types restricts the results to entries of the given types.
+func (c *ActivityListCall) Types(types ...ActivityType) *ActivityListCall {
+	if len(types) == 0 {
+		c.params.Del("types")
+		return c
+	}
+	codes := make([]string, len(types))
+	for i, t := range types {
+		codes[i] = string(t)
+	}
+	c.params.Set("types", strings.Join(codes, ","))
+	return c
+}
+
+// SuccessOnly restricts the results to successful entries.
+func (c *ActivityListCall) SuccessOnly(b bool) *ActivityListCall {
+	if b {
+		c.params.Set("success_only", "true")
+	} else {
+		c.params.Del("success_only")
+	}
+	return c
+}
+
+func (c *ActivityListCall) path() (string, error) {
+	return rangePath("me/activities", c.params, c.after, c.before)
+}
+
+func rangePath(resource string, params url.Values, after, before time.Time) (string, error) {
+	if !after.IsZero() && !before.IsZero() && after.After(before) {
+		return "", errors.New("account: CreatedAfter must not be later than CreatedBefore")
+	}
+	path := versioned(resource)
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+	return path, nil
+}
+
+func (c *ActivityListCall) Do() (*ActivityListResponse, error) {
+	return c.doContext(context.Background())
+}
+
+func (c *ActivityListCall) doContext(ctx context.Context) (*ActivityListResponse, error) {
+	path, err := c.path()
+	if err != nil {
+		return nil, err
+	}
+	ret := &ActivityListResponse{}
+	_, err = c.s.getContext(ctx, path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Iterate returns an iterator over the entries of the listing, starting
+// at the page set with PageToken if any. Pages are fetched one at a time,
+// when the previous one has been consumed.
+func (c *ActivityListCall) Iterate(ctx context.Context) *ActivityIterator {
+	it := &ActivityIterator{call: c, ctx: ctx}
+	it.info.NextPageToken = c.params.Get("page_token")
+	return it
+}
+
+// An ActivityIterator iterates over the entries of an activity listing.
+type ActivityIterator struct {
+	call *ActivityListCall
+	ctx  context.Context
+	info PageInfo
+	page []*Activity
+	err  error
+}
+
+// Next returns the next entry. It returns Done when the listing is
+// exhausted; any other error is returned again on subsequent calls.
+func (it *ActivityIterator) Next() (*Activity, error) {
+	for len(it.page) == 0 {
+		if it.err != nil {
+			return nil, it.err
+		}
+		if it.info.Fetched && it.info.NextPageToken == "" {
+			return nil, Done
+		}
+		it.err = it.fetch()
+	}
+	a := it.page[0]
+	it.page = it.page[1:]
+	return a, nil
+}
+
+// PageInfo returns the paging state of the iterator.
+func (it *ActivityIterator) PageInfo() PageInfo {
+	return it.info
+}
+
+func (it *ActivityIterator) fetch() error {
+	if err := it.ctx.Err(); err != nil {
+		return err
+	}
+	if it.info.NextPageToken != "" {
+		it.call.PageToken(it.info.NextPageToken)
+	}
+	res, err := it.call.doContext(it.ctx)
+	if err != nil {
+		return err
+	}
+	it.info = PageInfo{
+		Token:         it.info.NextPageToken,
+		NextPageToken: res.Result.NextPageToken,
+		Fetched:       true,
+	}
+	it.page = res.Result.Activities
+	return nil
+}
+
+type ActivityGetCall struct {
+	s  *Service
+	id string
+}
+
+// Get retrieves a single activity entry. Unknown IDs yield an error
+// matching ErrNotFound.
+func (r *ActivityService) Get(activityID string) *ActivityGetCall {
+	c := &ActivityGetCall{s: r.s, id: activityID}
+	return c
+}
+
+func (c *ActivityGetCall) Do() (*ActivityGetResponse, error) {
+	path := versioned("me/activities/" + url.PathEscape(c.id))
+	ret := &ActivityGetResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type activityExportResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		ExportID string `json:"export_id"`
+		Status   string `json:"status"`
+	} `json:"result"`
+}
+
+type ActivityExportCall struct {
+	s      *Service
+	ctx    context.Context
+	w      io.Writer
+	params url.Values
+
+	after, before time.Time
+	interval      time.Duration
+}
+
+// Export writes the activity log of the current account to w as CSV.
+//
+// When the server prepares the export asynchronously, Do waits for it to
+// be ready before downloading it.
+func (r *ActivityService) Export(ctx context.Context, w io.Writer) *ActivityExportCall {
+	c := &ActivityExportCall{s: r.s, ctx: ctx, w: w, params: url.Values{}}
+	return c
+}
+
+// CreatedAfter restricts the export to entries created at or after t.
+func (c *ActivityExportCall) CreatedAfter(t time.Time) *ActivityExportCall {
+	c.after = t
+	c.params.Set("created_after", t.UTC().Format(time.RFC3339))
+	return c
+}
+
+// CreatedBefore restricts the export to entries created before t.
+func (c *ActivityExportCall) CreatedBefore(t time.Time) *ActivityExportCall {
+	c.before = t
+	c.params.Set("created_before", t.UTC().Format(time.RFC3339))
+	return c
+}
+
+// PollInterval sets how often an asynchronous export is polled.
+func (c *ActivityExportCall) PollInterval(d time.Duration) *ActivityExportCall {
+	c.interval = d
+	return c
+}
+
+// Do performs the export and returns the number of exported rows, or -1
+// if the server did not report it.
+func (c *ActivityExportCall) Do() (int64, error) {
+	path, err := rangePath("me/activities/export", c.params, c.after, c.before)
+	if err != nil {
+		return 0, err
+	}
+
+	job := &activityExportResponse{}
+	resp, err := c.s.download(c.ctx, path, "text/csv", &mediaTarget{W: c.w, V: job})
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode == http.StatusAccepted {
+		resp, err = c.downloadJob(job.Result.ExportID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := strconv.ParseInt(resp.Header.Get("X-Total-Count"), 10, 64)
+	if err != nil {
+		return -1, nil
+	}
+	return n, nil
+}
+
+func (c *ActivityExportCall) downloadJob(id string) (*http.Response, error) {
+	path := versioned("me/activities/exports/" + url.PathEscape(id))
+	err := WaitFor(c.ctx, func() (bool, error) {
+		job := &activityExportResponse{}
+		if _, err := c.s.getContext(c.ctx, path, job); err != nil {
+			return false, err
+		}
+		switch job.Result.Status {
+		case "ready":
+			return true, nil
+		case "failed", "expired":
+			return false, fmt.Errorf("account: activity export %s %s", id, job.Result.Status)
+		}
+		return false, nil
+	}, WaitInterval(c.interval))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.s.download(c.ctx, path+"/download", "text/csv", c.w)
+}
+
+type ActivityClearResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Deleted int `json:"deleted"`
+	} `json:"result"`
+}
+
+type ActivityClearCall struct {
+	s       *Service
+	params  url.Values
+	confirm bool
+}
+
+// Clear deletes the activity log of the current account. The call must be
+// confirmed with Confirm, otherwise Do returns ErrNotConfirmed.
+func (r *ActivityService) Clear() *ActivityClearCall {
+	c := &ActivityClearCall{s: r.s, params: url.Values{}}
+	return c
+}
+
+// Before restricts the deletion to entries created before t.
+func (c *ActivityClearCall) Before(t time.Time) *ActivityClearCall {
+	c.params.Set("before", t.UTC().Format(time.RFC3339))
+	return c
+}
+
+// Confirm acknowledges that the deleted entries cannot be recovered.
+func (c *ActivityClearCall) Confirm() *ActivityClearCall {
+	c.confirm = true
+	return c
+}
+
+func (c *ActivityClearCall) Do() (*ActivityClearResponse, error) {
+	if !c.confirm {
+		return nil, ErrNotConfirmed
+	}
+	path := versioned("me/activities")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &ActivityClearResponse{}
+	_, err := c.s.delete(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SummaryInterval is the bucket width of an activity summary.
+type SummaryInterval string
+
+const (
+	IntervalDay   SummaryInterval = "day"
+	IntervalWeek  SummaryInterval = "week"
+	IntervalMonth SummaryInterval = "month"
+)
+
+// An ActivityBucket counts the activity entries of one summary interval,
+// by type.
+type ActivityBucket struct {
+	Start  time.Time            `json:"start"`
+	Counts map[ActivityType]int `json:"counts"`
+}
+
+type ActivitySummaryResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// IANA name of the account timezone the buckets are aligned to.
+		Timezone string            `json:"timezone"`
+		Interval SummaryInterval   `json:"interval"`
+		Buckets  []*ActivityBucket `json:"buckets"`
+	} `json:"result"`
+}
+
+type ActivitySummaryCall struct {
+	s      *Service
+	params url.Values
+
+	after, before time.Time
+}
+
+// Summary aggregates the activity log of the current account into
+// buckets of a fixed interval, aligned to the account timezone.
+func (r *ActivityService) Summary() *ActivitySummaryCall {
+	c := &ActivitySummaryCall{s: r.s, params: url.Values{}}
+	return c
+}
+
+// Interval sets the bucket width. The server defaults to IntervalDay.
+func (c *ActivitySummaryCall) Interval(i SummaryInterval) *ActivitySummaryCall {
+	c.params.Set("interval", string(i))
+	return c
+}
+
+// CreatedAfter restricts the summary to entries created at or after t.
+func (c *ActivitySummaryCall) CreatedAfter(t time.Time) *ActivitySummaryCall {
+	c.after = t
+	c.params.Set("created_after", t.UTC().Format(time.RFC3339))
+	return c
+}
+
+// CreatedBefore restricts the summary to entries created before t.
+func (c *ActivitySummaryCall) CreatedBefore(t time.Time) *ActivitySummaryCall {
+	c.before = t
+	c.params.Set("created_before", t.UTC().Format(time.RFC3339))
+	return c
+}
+
+func (c *ActivitySummaryCall) Do() (*ActivitySummaryResponse, error) {
+	switch i := SummaryInterval(c.params.Get("interval")); i {
+	case "", IntervalDay, IntervalWeek, IntervalMonth:
+	default:
+		return nil, fmt.Errorf("account: invalid summary interval %q", i)
+	}
+	path, err := rangePath("me/activities/summary", c.params, c.after, c.before)
+	if err != nil {
+		return nil, err
+	}
+	ret := &ActivitySummaryResponse{}
+	_, err = c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bucket starts carry the zone offset only; attach the named zone when
+	// this system knows it.
+	if tz := ret.Result.Timezone; tz != "" {
+		if loc, err := time.LoadLocation(tz); err == nil {
+			for _, b := range ret.Result.Buckets {
+				b.Start = b.Start.In(loc)
+			}
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,416 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_List(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/activities")
+		fmt.Fprint(w, `{"code":0,"message":"OK","result":{"activities":[
+			{"id":"a1","type":"login","success":false,"ip":"1.2.3.4","created_at":"2017-03-02T10:00:00Z"}
+		],"next_page_token":"p2"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Activity.List().Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(res.Result.Activities, HasLen, 1)
+	chk.Check(res.Result.Activities[0].Type, Equals, ActivityType("login"))
+	chk.Check(res.Result.Activities[0].CreatedAt.Equal(time.Date(2017, 3, 2, 10, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(res.Result.NextPageToken, Equals, "p2")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_List_Filters(chk *C) {
+	var query string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		fmt.Fprint(w, `{"code":0,"result":{"activities":[]}}`)
+	})
+	defer ts.Close()
+
+	after := time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2017, 4, 1, 8, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	_, err := svc.Me.Activity.List().
+		CreatedAfter(after).
+		CreatedBefore(before).
+		Types(ActivityLogin, ActivityPasswordChange).
+		SuccessOnly(true).
+		PageSize(50).
+		PageToken("p2").
+		Do()
+	chk.Assert(err, IsNil)
+	chk.Check(query, Equals, "created_after=2017-03-01T00%3A00%3A00Z"+
+		"&created_before=2017-04-01T00%3A00%3A00Z"+
+		"&page_size=50&page_token=p2&success_only=true"+
+		"&types=login%2Cpassword_change")
+
+	_, err = svc.Me.Activity.List().SuccessOnly(true).SuccessOnly(false).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(query, Equals, "")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_List_InvalidRange(chk *C) {
+	called := false
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+	defer ts.Close()
+
+	_, err := svc.Me.Activity.List().
+		CreatedAfter(time.Date(2017, 4, 1, 0, 0, 0, 0, time.UTC)).
+		CreatedBefore(time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC)).
+		Do()
+	chk.Check(err, NotNil)
+	chk.Check(called, Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_Get(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.EscapedPath(), Equals, "/v1.1/me/activities/a1")
+		fmt.Fprint(w, `{"code":0,"result":{"id":"a1","type":"login","success":true,
+			"user_agent":"Mozilla/5.0 (X11)","geo":{"latitude":25.03,"longitude":121.56}}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Activity.Get("a1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.ID, Equals, "a1")
+	chk.Check(res.Result.UserAgent, Equals, "Mozilla/5.0 (X11)")
+	chk.Assert(res.Result.Geo, NotNil)
+	chk.Check(res.Result.Geo.Latitude, Equals, 25.03)
+	chk.Check(res.Result.Geo.Longitude, Equals, 121.56)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_Get_NotFound(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"code":404,"message":"activity not found"}`)
+	})
+	defer ts.Close()
+
+	_, err := svc.Me.Activity.Get("missing").Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_Get_Escaping(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.EscapedPath(), Equals, "/v1.1/me/activities/a%2Fb%20c%3Fd")
+		fmt.Fprint(w, `{"code":0,"result":{"id":"a/b c?d"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Activity.Get("a/b c?d").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.ID, Equals, "a/b c?d")
+}
+
+const activityCSV = "id,type,success,created_at\na1,login,false,2017-03-02T10:00:00Z\na2,login,true,2017-03-03T11:00:00Z\n"
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_Export(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/activities/export")
+		chk.Check(r.URL.RawQuery, Equals, "created_after=2017-03-01T00%3A00%3A00Z")
+		chk.Check(r.Header.Get("Accept"), Equals, "text/csv")
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("X-Total-Count", "2")
+		fmt.Fprint(w, activityCSV)
+	})
+	defer ts.Close()
+
+	buf := &bytes.Buffer{}
+	n, err := svc.Me.Activity.Export(context.Background(), buf).
+		CreatedAfter(time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC)).
+		Do()
+	chk.Assert(err, IsNil)
+	chk.Check(n, Equals, int64(2))
+	chk.Check(buf.String(), Equals, activityCSV)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_Export_Async(chk *C) {
+	polls := 0
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1.1/me/activities/export":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{"code":0,"result":{"export_id":"e1","status":"pending"}}`)
+		case "/v1.1/me/activities/exports/e1":
+			polls++
+			status := "pending"
+			if polls == 2 {
+				status = "ready"
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"code":0,"result":{"export_id":"e1","status":%q}}`, status)
+		case "/v1.1/me/activities/exports/e1/download":
+			chk.Check(r.Header.Get("Accept"), Equals, "text/csv")
+			w.Header().Set("Content-Type", "text/csv")
+			fmt.Fprint(w, activityCSV)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer ts.Close()
+
+	buf := &bytes.Buffer{}
+	n, err := svc.Me.Activity.Export(context.Background(), buf).PollInterval(time.Millisecond).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(n, Equals, int64(-1))
+	chk.Check(polls, Equals, 2)
+	chk.Check(buf.String(), Equals, activityCSV)
+}
+
+// cancelWriter cancels a context as soon as it receives data.
+type cancelWriter struct {
+	buf    bytes.Buffer
+	cancel context.CancelFunc
+}
+
+func (w *cancelWriter) Write(p []byte) (int, error) {
+	w.cancel()
+	return w.buf.Write(p)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_Export_Cancel(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		fmt.Fprint(w, "id,type,success,created_at\n")
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+			fmt.Fprint(w, "a1,login,false,2017-03-02T10:00:00Z\n")
+		}
+	})
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := &cancelWriter{cancel: cancel}
+	_, err := svc.Me.Activity.Export(ctx, w).Do()
+	chk.Check(errors.Is(err, context.Canceled), Equals, true)
+	chk.Check(w.buf.String(), Equals, "id,type,success,created_at\n")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_ActivityType_Parse(chk *C) {
+	for _, code := range []string{"login", " Password_Change ", "DEVICE_REGISTERED"} {
+		t, err := ParseActivityType(code)
+		chk.Assert(err, IsNil)
+		chk.Check(t.IsKnown(), Equals, true, Commentf("%q", code))
+	}
+	t, err := ParseActivityType("login")
+	chk.Assert(err, IsNil)
+	chk.Check(t, Equals, ActivityLogin)
+
+	t, err = ParseActivityType("passkey_added")
+	chk.Assert(err, IsNil)
+	chk.Check(t, Equals, ActivityType("passkey_added"))
+	chk.Check(t.IsKnown(), Equals, false)
+
+	_, err = ParseActivityType(" ")
+	chk.Check(err, NotNil)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_ActivityType_Unknown_RoundTrip(chk *C) {
+	a := &Activity{}
+	chk.Assert(json.Unmarshal([]byte(`{"id":"a1","type":"passkey_added"}`), a), IsNil)
+	chk.Check(a.Type, Equals, ActivityType("passkey_added"))
+	chk.Check(a.Type.IsKnown(), Equals, false)
+
+	b, err := json.Marshal(a)
+	chk.Assert(err, IsNil)
+	chk.Check(strings.Contains(string(b), `"type":"passkey_added"`), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_ActivityType_Filter(chk *C) {
+	var types string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		types = r.URL.Query().Get("types")
+		fmt.Fprint(w, `{"code":0,"result":{"activities":[]}}`)
+	})
+	defer ts.Close()
+
+	_, err := svc.Me.Activity.List().Types(ActivityLogin, ActivityTwoFactorDisabled, "passkey_added").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(types, Equals, "login,two_factor_disabled,passkey_added")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_Clear(chk *C) {
+	var query string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "DELETE")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/activities")
+		query = r.URL.RawQuery
+		fmt.Fprint(w, `{"code":0,"result":{"deleted":42}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Activity.Clear().Confirm().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Deleted, Equals, 42)
+	chk.Check(query, Equals, "")
+
+	_, err = svc.Me.Activity.Clear().Before(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)).Confirm().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(query, Equals, "before=2017-01-01T00%3A00%3A00Z")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_Clear_NotConfirmed(chk *C) {
+	called := false
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+	defer ts.Close()
+
+	_, err := svc.Me.Activity.Clear().Do()
+	chk.Check(err, Equals, ErrNotConfirmed)
+	chk.Check(called, Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_Summary(chk *C) {
+	var query string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/activities/summary")
+		query = r.URL.RawQuery
+		fmt.Fprint(w, `{"code":0,"result":{"timezone":"Asia/Taipei","interval":"week","buckets":[
+			{"start":"2017-02-27T00:00:00+08:00","counts":{"login":12,"password_change":1}},
+			{"start":"2017-03-06T00:00:00+08:00","counts":{"login":3,"passkey_added":2}},
+			{"start":"2017-03-13T00:00:00+08:00","counts":{}}
+		]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Activity.Summary().
+		Interval(IntervalWeek).
+		CreatedAfter(time.Date(2017, 2, 26, 16, 0, 0, 0, time.UTC)).
+		Do()
+	chk.Assert(err, IsNil)
+	chk.Check(query, Equals, "created_after=2017-02-26T16%3A00%3A00Z&interval=week")
+
+	chk.Check(res.Result.Timezone, Equals, "Asia/Taipei")
+	chk.Check(res.Result.Interval, Equals, IntervalWeek)
+	chk.Assert(res.Result.Buckets, HasLen, 3)
+	b := res.Result.Buckets[0]
+	chk.Check(b.Start.Location().String(), Equals, "Asia/Taipei")
+	chk.Check(b.Start.Equal(time.Date(2017, 2, 26, 16, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(b.Start.Day(), Equals, 27)
+	chk.Check(b.Counts[ActivityLogin], Equals, 12)
+	chk.Check(b.Counts[ActivityPasswordChange], Equals, 1)
+	chk.Check(res.Result.Buckets[1].Counts[ActivityType("passkey_added")], Equals, 2)
+	chk.Check(res.Result.Buckets[2].Counts, HasLen, 0)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_Summary_InvalidInterval(chk *C) {
+	svc := New(nil)
+	_, err := svc.Me.Activity.Summary().Interval("hour").Do()
+	chk.Check(err, ErrorMatches, `.*invalid summary interval "hour"`)
+}
+
+// activityPager serves a five-page listing of two entries per page.
+func activityPager(requested *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("page_token")
+		*requested = append(*requested, token)
+		page := 1
+		if token != "" {
+			fmt.Sscanf(token, "p%d", &page)
+		}
+		next := ""
+		if page < 5 {
+			next = fmt.Sprintf("p%d", page+1)
+		}
+		fmt.Fprintf(w, `{"code":0,"result":{"activities":[{"id":"a%d"},{"id":"a%d"}],"next_page_token":%q}}`,
+			2*page-1, 2*page, next)
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_Iterate(chk *C) {
+	var requested []string
+	svc, ts := newFakeService(activityPager(&requested))
+	defer ts.Close()
+
+	it := svc.Me.Activity.List().PageSize(2).Iterate(context.Background())
+	chk.Check(requested, HasLen, 0)
+
+	a, err := it.Next()
+	chk.Assert(err, IsNil)
+	chk.Check(a.ID, Equals, "a1")
+	a, err = it.Next()
+	chk.Assert(err, IsNil)
+	chk.Check(a.ID, Equals, "a2")
+	chk.Check(requested, DeepEquals, []string{""})
+	chk.Check(it.PageInfo(), Equals, PageInfo{Token: "", NextPageToken: "p2", Fetched: true})
+
+	a, err = it.Next()
+	chk.Assert(err, IsNil)
+	chk.Check(a.ID, Equals, "a3")
+	chk.Check(requested, DeepEquals, []string{"", "p2"})
+
+	n := 3
+	for {
+		_, err = it.Next()
+		if err == Done {
+			break
+		}
+		chk.Assert(err, IsNil)
+		n++
+	}
+	chk.Check(n, Equals, 10)
+	chk.Check(requested, DeepEquals, []string{"", "p2", "p3", "p4", "p5"})
+	_, err = it.Next()
+	chk.Check(err, Equals, Done)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_Iterate_Resume(chk *C) {
+	var requested []string
+	svc, ts := newFakeService(activityPager(&requested))
+	defer ts.Close()
+
+	it := svc.Me.Activity.List().Iterate(context.Background())
+	for i := 0; i < 4; i++ {
+		_, err := it.Next()
+		chk.Assert(err, IsNil)
+	}
+	saved := it.PageInfo().NextPageToken
+	chk.Check(saved, Equals, "p3")
+
+	var ids []string
+	it = svc.Me.Activity.List().PageToken(saved).Iterate(context.Background())
+	for {
+		a, err := it.Next()
+		if err == Done {
+			break
+		}
+		chk.Assert(err, IsNil)
+		ids = append(ids, a.ID)
+	}
+	chk.Check(ids, DeepEquals, []string{"a5", "a6", "a7", "a8", "a9", "a10"})
+	chk.Check(requested[2:], DeepEquals, []string{"p3", "p4", "p5"})
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Activity_Iterate_Cancel(chk *C) {
+	var requested []string
+	svc, ts := newFakeService(activityPager(&requested))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := svc.Me.Activity.List().Iterate(ctx)
+	_, err := it.Next()
+	chk.Assert(err, IsNil)
+	_, err = it.Next()
+	chk.Assert(err, IsNil)
+	cancel()
+	_, err = it.Next()
+	chk.Check(err, Equals, context.Canceled)
+	chk.Check(requested, HasLen, 1)
+}
@@ -0,0 +1,155 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Scope is a permission granted to an API key. Scopes unknown to this
+// package are kept verbatim when decoding.
+type Scope string
+
+const (
+	ScopeProfileRead   Scope = "profile:read"
+	ScopeProfileWrite  Scope = "profile:write"
+	ScopeDevicesRead   Scope = "devices:read"
+	ScopeDevicesWrite  Scope = "devices:write"
+	ScopeFriendsRead   Scope = "friends:read"
+	ScopeFriendsWrite  Scope = "friends:write"
+	ScopeActivityRead  Scope = "activity:read"
+	ScopeSecurityWrite Scope = "security:write"
+)
+
+// IsKnown reports whether s is one of the documented scopes.
+func (s Scope) IsKnown() bool {
+	switch s {
+	case ScopeProfileRead, ScopeProfileWrite, ScopeDevicesRead, ScopeDevicesWrite,
+		ScopeFriendsRead, ScopeFriendsWrite, ScopeActivityRead, ScopeSecurityWrite:
+		return true
+	}
+	return false
+}
+
+// An APIKey is a long-lived personal credential of the account.
+type APIKey struct {
+	ID         string    `json:"key_id"`
+	Name       string    `json:"name"`
+	Scopes     []Scope   `json:"scopes"`
+	Prefix     string    `json:"prefix"` // first characters of the secret, for identification
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
+
+type APIKeysService struct {
+	s *Service
+}
+
+func NewAPIKeysService(s *Service) *APIKeysService {
+	rs := &APIKeysService{s: s}
+	return rs
+}
+
+type APIKeyCreateResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		APIKey
+
+		// Secret is the key itself. It is only ever returned here.
+		Secret Secret `json:"secret"`
+	} `json:"result"`
+}
+
+type APIKeyCreateCall struct {
+	s      *Service
+	name   string
+	scopes []string
+}
+
+// Create issues a new API key with the given scopes, all of which must be
+// documented scopes.
+func (r *APIKeysService) Create(name string, scopes []string) *APIKeyCreateCall {
+	c := &APIKeyCreateCall{s: r.s, name: name, scopes: scopes}
+	return c
+}
+
+func (c *APIKeyCreateCall) Do() (*APIKeyCreateResponse, error) {
+	if strings.TrimSpace(c.name) == "" {
+		return nil, errors.New("account: API key name is empty")
+	}
+	if len(c.scopes) == 0 {
+		return nil, errors.New("account: API key needs at least one scope")
+	}
+	scopes := make([]Scope, 0, len(c.scopes))
+	seen := make(map[Scope]bool)
+	for _, v := range c.scopes {
+		sc := Scope(v)
+		if !sc.IsKnown() {
+			return nil, fmt.Errorf("account: unknown scope %q", v)
+		}
+		if !seen[sc] {
+			seen[sc] = true
+			scopes = append(scopes, sc)
+		}
+	}
+
+	path := versioned("me/api-keys")
+	payload := struct {
+		Name   string  `json:"name"`
+		Scopes []Scope `json:"scopes"`
+	}{c.name, scopes}
+	ret := &APIKeyCreateResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type APIKeyListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Keys []*APIKey `json:"keys"`
+	} `json:"result"`
+}
+
+type APIKeyListCall struct {
+	s *Service
+}
+
+// List lists the API keys of the account. Secrets are never included.
+func (r *APIKeysService) List() *APIKeyListCall {
+	c := &APIKeyListCall{s: r.s}
+	return c
+}
+
+func (c *APIKeyListCall) Do() (*APIKeyListResponse, error) {
+	path := versioned("me/api-keys")
+	ret := &APIKeyListResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type APIKeyRevokeCall struct {
+	s  *Service
+	id string
+}
+
+// Revoke permanently disables an API key.
+func (r *APIKeysService) Revoke(keyID string) *APIKeyRevokeCall {
+	c := &APIKeyRevokeCall{s: r.s, id: keyID}
+	return c
+}
+
+func (c *APIKeyRevokeCall) Do() error {
+	path := versioned("me/api-keys/" + url.PathEscape(c.id))
+	_, err := c.s.delete(path, nil, nil)
+	return err
+}
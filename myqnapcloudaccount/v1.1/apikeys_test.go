@@ -0,0 +1,85 @@
+package account
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+// fakeAPIKeysAPI keeps the API keys of one account in memory.
+func fakeAPIKeysAPI(chk *C) http.HandlerFunc {
+	keys := map[string]string{}
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/v1.1/me/api-keys":
+			var body struct {
+				Name   string   `json:"name"`
+				Scopes []string `json:"scopes"`
+			}
+			chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+			chk.Check(body.Scopes, DeepEquals, []string{"devices:read", "profile:read"})
+			keys["k1"] = body.Name
+			fmt.Fprintf(w, `{"code":0,"result":{"key_id":"k1","name":%q,"scopes":["devices:read","profile:read"],
+				"prefix":"qk_live_ab","created_at":"2017-02-23T08:00:00Z","secret":"qk_live_abcdef0123456789"}}`, body.Name)
+		case r.Method == "GET" && r.URL.Path == "/v1.1/me/api-keys":
+			fmt.Fprint(w, `{"code":0,"result":{"keys":[`)
+			if name, ok := keys["k1"]; ok {
+				fmt.Fprintf(w, `{"key_id":"k1","name":%q,"scopes":["devices:read","profile:read","billing:read"],
+					"prefix":"qk_live_ab","created_at":"2017-02-23T08:00:00Z","last_used_at":"2017-02-23T09:00:00Z"}`, name)
+			}
+			fmt.Fprint(w, `]}}`)
+		case r.Method == "DELETE" && r.URL.Path == "/v1.1/me/api-keys/k1":
+			delete(keys, "k1")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_APIKeys_Lifecycle(chk *C) {
+	svc, ts := newFakeService(fakeAPIKeysAPI(chk))
+	defer ts.Close()
+
+	logs := &bytes.Buffer{}
+	log.SetOutput(logs)
+	defer log.SetOutput(os.Stderr)
+	svc.Debug = true
+
+	created, err := svc.Me.APIKeys.Create("backup script", []string{"devices:read", "profile:read", "devices:read"}).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(created.Result.ID, Equals, "k1")
+	chk.Check(string(created.Result.Secret), Equals, "qk_live_abcdef0123456789")
+	chk.Check(fmt.Sprintf("%v %+v", created, created.Result), Not(Matches), "(?s).*abcdef0123456789.*")
+	chk.Check(strings.Contains(logs.String(), "abcdef0123456789"), Equals, false)
+
+	list, err := svc.Me.APIKeys.List().Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(list.Result.Keys, HasLen, 1)
+	k := list.Result.Keys[0]
+	chk.Check(k.Name, Equals, "backup script")
+	chk.Check(k.Scopes, DeepEquals, []Scope{ScopeDevicesRead, ScopeProfileRead, "billing:read"})
+	chk.Check(k.Scopes[2].IsKnown(), Equals, false)
+	chk.Check(k.LastUsedAt.IsZero(), Equals, false)
+
+	chk.Assert(svc.Me.APIKeys.Revoke("k1").Do(), IsNil)
+	list, err = svc.Me.APIKeys.List().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(list.Result.Keys, HasLen, 0)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_APIKeys_Create_Invalid(chk *C) {
+	svc := New(nil)
+	_, err := svc.Me.APIKeys.Create("ci", []string{"profile:read", "root"}).Do()
+	chk.Check(err, ErrorMatches, `account: unknown scope "root"`)
+	_, err = svc.Me.APIKeys.Create("ci", nil).Do()
+	chk.Check(err, ErrorMatches, ".*at least one scope")
+	_, err = svc.Me.APIKeys.Create(" ", []string{"profile:read"}).Do()
+	chk.Check(err, ErrorMatches, ".*name is empty")
+}
@@ -0,0 +1,123 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ErrNoAvatar is returned when downloading the avatar of a user who has
+// none, or whose avatar is private.
+var ErrNoAvatar = errors.New("account: no avatar")
+
+// AvatarResponse describes a downloaded avatar.
+type AvatarResponse struct {
+	// ContentType is the media type of the image, such as "image/png".
+	ContentType string
+
+	// ContentLength is the size of the image in bytes, or -1 if unknown.
+	ContentLength int64
+
+	// ETag identifies this version of the avatar, for IfNoneMatch.
+	ETag string
+}
+
+// avatarCall holds the options shared by the avatar downloads.
+type avatarCall struct {
+	s      *Service
+	ctx    context.Context
+	path   string
+	w      io.Writer
+	params url.Values
+	header http.Header
+}
+
+func newAvatarCall(s *Service, ctx context.Context, path string, w io.Writer) avatarCall {
+	return avatarCall{s: s, ctx: ctx, path: path, w: w, params: url.Values{}, header: http.Header{}}
+}
+
+func (c *avatarCall) do() (*AvatarResponse, error) {
+	path := c.path
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	req, err := c.s.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "image/*")
+	for k, v := range c.header {
+		req.Header[k] = v
+	}
+	resp, err := c.s.do(req.WithContext(c.ctx), c.w)
+	if errors.Is(err, ErrNotFound) || errors.Is(err, ErrForbidden) {
+		return nil, ErrNoAvatar
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &AvatarResponse{
+		ContentType:   resp.Header.Get("Content-Type"),
+		ContentLength: resp.ContentLength,
+		ETag:          resp.Header.Get("ETag"),
+	}, nil
+}
+
+type UserAvatarCall struct {
+	avatarCall
+}
+
+// Avatar writes the avatar image of a user to w.
+func (r *UserService) Avatar(ctx context.Context, userID string, w io.Writer) *UserAvatarCall {
+	c := &UserAvatarCall{newAvatarCall(r.s, ctx, versioned("users/"+url.PathEscape(userID)+"/avatar"), w)}
+	return c
+}
+
+// Size selects a square thumbnail of the given size in pixels instead of
+// the original image.
+func (c *UserAvatarCall) Size(px int) *UserAvatarCall {
+	c.params.Set("size", strconv.Itoa(px))
+	return c
+}
+
+// IfNoneMatch makes the call fail with an error matching ErrNotModified,
+// writing nothing, if the avatar still has the given ETag.
+func (c *UserAvatarCall) IfNoneMatch(etag string) *UserAvatarCall {
+	c.header.Set("If-None-Match", etag)
+	return c
+}
+
+func (c *UserAvatarCall) Do() (*AvatarResponse, error) {
+	return c.do()
+}
+
+type AvatarDownloadCall struct {
+	avatarCall
+}
+
+// Download writes the avatar image of the account to w.
+func (r *AvatarService) Download(ctx context.Context, w io.Writer) *AvatarDownloadCall {
+	c := &AvatarDownloadCall{newAvatarCall(r.s, ctx, versioned("me/avatar"), w)}
+	return c
+}
+
+// Size selects a square thumbnail of the given size in pixels instead of
+// the original image.
+func (c *AvatarDownloadCall) Size(px int) *AvatarDownloadCall {
+	c.params.Set("size", strconv.Itoa(px))
+	return c
+}
+
+// IfNoneMatch makes the call fail with an error matching ErrNotModified,
+// writing nothing, if the avatar still has the given ETag.
+func (c *AvatarDownloadCall) IfNoneMatch(etag string) *AvatarDownloadCall {
+	c.header.Set("If-None-Match", etag)
+	return c
+}
+
+func (c *AvatarDownloadCall) Do() (*AvatarResponse, error) {
+	return c.do()
+}
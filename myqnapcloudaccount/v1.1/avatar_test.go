@@ -0,0 +1,76 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_Avatar(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.Header.Get("Accept"), Equals, "image/*")
+		switch r.URL.Path {
+		case "/v1.1/users/u1/avatar":
+			if r.Header.Get("If-None-Match") == `"a1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			if r.URL.Query().Get("size") == "64" {
+				w.Header().Set("Content-Type", "image/jpeg")
+				w.Write([]byte("thumb"))
+				return
+			}
+			w.Header().Set("Content-Type", "image/png")
+			w.Header().Set("ETag", `"a1"`)
+			w.Write(pngHeader)
+		case "/v1.1/users/u2/avatar":
+			w.WriteHeader(http.StatusNotFound)
+		case "/v1.1/users/u3/avatar":
+			w.WriteHeader(http.StatusForbidden)
+		}
+	})
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	res, err := svc.User.Avatar(context.Background(), "u1", &buf).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(buf.Bytes(), DeepEquals, pngHeader)
+	chk.Check(*res, Equals, AvatarResponse{ContentType: "image/png", ContentLength: int64(len(pngHeader)), ETag: `"a1"`})
+
+	buf.Reset()
+	_, err = svc.User.Avatar(context.Background(), "u1", &buf).IfNoneMatch(res.ETag).Do()
+	chk.Check(errors.Is(err, ErrNotModified), Equals, true)
+	chk.Check(buf.Len(), Equals, 0)
+
+	res, err = svc.User.Avatar(context.Background(), "u1", &buf).Size(64).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(buf.String(), Equals, "thumb")
+	chk.Check(res.ContentType, Equals, "image/jpeg")
+
+	for _, id := range []string{"u2", "u3"} {
+		_, err = svc.User.Avatar(context.Background(), id, &buf).Do()
+		chk.Check(err, Equals, ErrNoAvatar)
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Me_AvatarDownload(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/avatar")
+		chk.Check(r.URL.Query().Get("size"), Equals, "128")
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(pngHeader)
+	})
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	res, err := svc.Me.Avatar.Download(context.Background(), &buf).Size(128).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(buf.Bytes(), DeepEquals, pngHeader)
+	chk.Check(res.ContentType, Equals, "image/png")
+}
@@ -0,0 +1,21 @@
+package account
+
+import "fmt"
+
+// An ItemError is the failure of one item of a batch call, the rest of
+// which may have succeeded. errors.Is matches it against the package
+// sentinel errors as it does an ErrorResponse.
+type ItemError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error implements the error interface.
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("account: %s (code %d)", e.Message, e.Code)
+}
+
+// Is reports whether the error matches one of the package sentinel errors.
+func (e *ItemError) Is(target error) bool {
+	return e.Code != 0 && apiErrors[e.Code] == target
+}
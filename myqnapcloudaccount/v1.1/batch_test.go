@@ -0,0 +1,15 @@
+package account
+
+import (
+	"errors"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_ItemError(chk *C) {
+	var err error = &ItemError{Code: codeAlreadyFriends, Message: "already friends"}
+	chk.Check(err, ErrorMatches, `account: already friends \(code 40907\)`)
+	chk.Check(errors.Is(err, ErrAlreadyFriends), Equals, true)
+	chk.Check(errors.Is(err, ErrNotFound), Equals, false)
+	chk.Check(errors.Is(&ItemError{Message: "unknown"}, ErrAlreadyFriends), Equals, false)
+}
@@ -0,0 +1,66 @@
+package account
+
+// ProfileField identifies a profile field in completeness hints. Fields
+// unknown to this package are kept verbatim.
+type ProfileField string
+
+// The profile fields set through the MeUpdateCall setter of the same name.
+const (
+	FieldFirstName   ProfileField = "first_name"
+	FieldLastName    ProfileField = "last_name"
+	FieldDisplayName ProfileField = "display_name"
+	FieldLanguage    ProfileField = "language"
+	FieldGender      ProfileField = "gender"
+	FieldBirthday    ProfileField = "birthday"
+	FieldCountry     ProfileField = "country"
+	FieldTimezone    ProfileField = "timezone"
+)
+
+// The profile fields set through other services.
+const (
+	FieldPhone  ProfileField = "phone"  // Me.Phone
+	FieldAvatar ProfileField = "avatar" // Me.Avatar
+)
+
+// A MissingField is a hint about a profile field left to fill in.
+type MissingField struct {
+	Field ProfileField `json:"field"`
+
+	// Hint is a message suitable for display, such as "Add a phone
+	// number".
+	Hint string `json:"hint"`
+
+	// Weight is the number of percentage points the field adds.
+	Weight int `json:"weight"`
+}
+
+type CompletenessResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Percent is the completeness of the profile, from 0 to 100.
+		Percent int             `json:"percent"`
+		Missing []*MissingField `json:"missing"`
+	} `json:"result"`
+}
+
+type MeCompletenessCall struct {
+	s *Service
+}
+
+// Completeness reports how complete the profile is and which fields are
+// left to fill in.
+func (r *MeService) Completeness() *MeCompletenessCall {
+	c := &MeCompletenessCall{s: r.s}
+	return c
+}
+
+func (c *MeCompletenessCall) Do() (*CompletenessResponse, error) {
+	path := versioned("me/completeness")
+	ret := &CompletenessResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
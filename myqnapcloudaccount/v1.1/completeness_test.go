@@ -0,0 +1,44 @@
+package account
+
+import (
+	"fmt"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Completeness_Missing(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/completeness")
+		fmt.Fprint(w, `{"code":0,"result":{"percent":70,"missing":[
+			{"field":"phone","hint":"Add a phone number","weight":15},
+			{"field":"birthday","hint":"Add your birthday","weight":10},
+			{"field":"pronouns","hint":"Add your pronouns","weight":5}
+		]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Completeness().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Percent, Equals, 70)
+	m := res.Result.Missing
+	chk.Assert(m, HasLen, 3)
+	chk.Check(m[0].Field, Equals, FieldPhone)
+	chk.Check(m[0].Hint, Equals, "Add a phone number")
+	chk.Check(m[0].Weight, Equals, 15)
+	chk.Check(m[1].Field, Equals, FieldBirthday)
+	chk.Check(m[2].Field, Equals, ProfileField("pronouns"))
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Completeness_Complete(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"code":0,"result":{"percent":100,"missing":[]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Completeness().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Percent, Equals, 100)
+	chk.Check(res.Result.Missing, HasLen, 0)
+}
@@ -0,0 +1,104 @@
+package account
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// A ConnectedApp is a third-party application granted access to the
+// account through OAuth.
+type ConnectedApp struct {
+	ID         string    `json:"app_id"`
+	Name       string    `json:"name"`
+	Scopes     []string  `json:"scopes"`
+	GrantedAt  time.Time `json:"granted_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
+
+type ConnectedAppListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Apps          []*ConnectedApp `json:"apps"`
+		NextPageToken string          `json:"next_page_token"`
+	} `json:"result"`
+}
+
+type ConnectedAppsService struct {
+	s *Service
+}
+
+func NewConnectedAppsService(s *Service) *ConnectedAppsService {
+	rs := &ConnectedAppsService{s: s}
+	return rs
+}
+
+type ConnectedAppListCall struct {
+	s      *Service
+	params url.Values
+}
+
+// List lists the applications granted access to the account.
+func (r *ConnectedAppsService) List() *ConnectedAppListCall {
+	c := &ConnectedAppListCall{s: r.s, params: url.Values{}}
+	return c
+}
+
+// PageSize sets the maximum number of applications returned per page.
+func (c *ConnectedAppListCall) PageSize(n int) *ConnectedAppListCall {
+	c.params.Set("page_size", strconv.Itoa(n))
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *ConnectedAppListCall) PageToken(token string) *ConnectedAppListCall {
+	c.params.Set("page_token", token)
+	return c
+}
+
+func (c *ConnectedAppListCall) Do() (*ConnectedAppListResponse, error) {
+	path := versioned("me/connected-apps")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &ConnectedAppListResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type ConnectedAppRevokeResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// SelfRevoked is set when the revoked grant is the one the call
+		// was made with: the credentials of this Service no longer work.
+		SelfRevoked bool `json:"self_revoked"`
+	} `json:"result"`
+}
+
+type ConnectedAppRevokeCall struct {
+	s  *Service
+	id string
+}
+
+// Revoke removes the access granted to an application and invalidates
+// its tokens.
+func (r *ConnectedAppsService) Revoke(appID string) *ConnectedAppRevokeCall {
+	c := &ConnectedAppRevokeCall{s: r.s, id: appID}
+	return c
+}
+
+func (c *ConnectedAppRevokeCall) Do() (*ConnectedAppRevokeResponse, error) {
+	path := versioned("me/connected-apps/" + url.PathEscape(c.id))
+	ret := &ConnectedAppRevokeResponse{}
+	_, err := c.s.delete(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
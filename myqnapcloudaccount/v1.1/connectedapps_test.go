@@ -0,0 +1,57 @@
+package account
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_ConnectedApps_List(chk *C) {
+	var query string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/connected-apps")
+		query = r.URL.RawQuery
+		fmt.Fprint(w, `{"code":0,"result":{"apps":[
+			{"app_id":"qsync","name":"Qsync","scopes":["profile","devices"],
+			 "granted_at":"2016-11-02T03:04:05Z","last_used_at":"2017-02-22T10:00:00Z"},
+			{"app_id":"nas-backup","name":"NAS Backup","scopes":["profile"],"granted_at":"2017-01-05T00:00:00Z"}
+		],"next_page_token":"c2"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.ConnectedApps.List().PageSize(2).PageToken("c1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(query, Equals, "page_size=2&page_token=c1")
+	chk.Check(res.Result.NextPageToken, Equals, "c2")
+	chk.Assert(res.Result.Apps, HasLen, 2)
+	app := res.Result.Apps[0]
+	chk.Check(app.ID, Equals, "qsync")
+	chk.Check(app.Scopes, DeepEquals, []string{"profile", "devices"})
+	chk.Check(app.LastUsedAt.Equal(time.Date(2017, 2, 22, 10, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(res.Result.Apps[1].LastUsedAt.IsZero(), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_ConnectedApps_Revoke(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "DELETE")
+		switch r.URL.EscapedPath() {
+		case "/v1.1/me/connected-apps/nas-backup":
+			fmt.Fprint(w, `{"code":0,"result":{"self_revoked":false}}`)
+		case "/v1.1/me/connected-apps/this%20app":
+			fmt.Fprint(w, `{"code":0,"result":{"self_revoked":true}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.ConnectedApps.Revoke("nas-backup").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.SelfRevoked, Equals, false)
+
+	res, err = svc.Me.ConnectedApps.Revoke("this app").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.SelfRevoked, Equals, true)
+}
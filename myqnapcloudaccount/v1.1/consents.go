@@ -0,0 +1,105 @@
+package account
+
+import (
+	"errors"
+	"time"
+)
+
+// DocumentType identifies a legal document consented to. Types unknown to
+// this package are kept verbatim.
+type DocumentType string
+
+const (
+	DocumentPrivacyPolicy  DocumentType = "privacy_policy"
+	DocumentTermsOfService DocumentType = "terms_of_service"
+	DocumentMarketing      DocumentType = "marketing"
+)
+
+// ConsentAction tells whether a consent was given or withdrawn.
+type ConsentAction string
+
+const (
+	ConsentAccepted  ConsentAction = "accepted"
+	ConsentWithdrawn ConsentAction = "withdrawn"
+)
+
+// A Consent records a consent given or withdrawn by the account holder.
+type Consent struct {
+	DocumentType DocumentType  `json:"document_type"`
+	Version      string        `json:"version"`
+	Action       ConsentAction `json:"action"`
+	RecordedAt   time.Time     `json:"recorded_at"`
+	SourceIP     string        `json:"source_ip"`
+}
+
+type ConsentListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Consents []*Consent `json:"consents"`
+	} `json:"result"`
+}
+
+type ConsentsService struct {
+	s *Service
+}
+
+func NewConsentsService(s *Service) *ConsentsService {
+	rs := &ConsentsService{s: s}
+	return rs
+}
+
+type ConsentListCall struct {
+	s *Service
+}
+
+// List lists the consent history of the account, oldest first.
+func (r *ConsentsService) List() *ConsentListCall {
+	c := &ConsentListCall{s: r.s}
+	return c
+}
+
+func (c *ConsentListCall) Do() (*ConsentListResponse, error) {
+	path := versioned("me/consents")
+	ret := &ConsentListResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type ConsentAcceptResponse struct {
+	Message string  `json:"message"`
+	Code    int     `json:"code"`
+	Result  Consent `json:"result"`
+}
+
+type ConsentAcceptCall struct {
+	s       *Service
+	docType DocumentType
+	version string
+}
+
+// Accept records the acceptance of a version of a legal document.
+func (r *ConsentsService) Accept(documentType DocumentType, version string) *ConsentAcceptCall {
+	c := &ConsentAcceptCall{s: r.s, docType: documentType, version: version}
+	return c
+}
+
+func (c *ConsentAcceptCall) Do() (*ConsentAcceptResponse, error) {
+	if c.docType == "" || c.version == "" {
+		return nil, errors.New("account: consent needs a document type and version")
+	}
+	path := versioned("me/consents")
+	payload := struct {
+		DocumentType DocumentType `json:"document_type"`
+		Version      string       `json:"version"`
+	}{c.docType, c.version}
+	ret := &ConsentAcceptResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
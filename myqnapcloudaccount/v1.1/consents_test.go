@@ -0,0 +1,58 @@
+package account
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Consents_List(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/consents")
+		fmt.Fprint(w, `{"code":0,"result":{"consents":[
+			{"document_type":"terms_of_service","version":"2016-01","action":"accepted","recorded_at":"2016-01-05T00:00:00Z","source_ip":"203.0.113.7"},
+			{"document_type":"marketing","version":"1","action":"accepted","recorded_at":"2016-01-05T00:00:00Z","source_ip":"203.0.113.7"},
+			{"document_type":"marketing","version":"1","action":"withdrawn","recorded_at":"2016-09-10T00:00:00Z","source_ip":"198.51.100.2"},
+			{"document_type":"data_processing_agreement","version":"3","action":"accepted","recorded_at":"2017-01-02T00:00:00Z"}
+		]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Consents.List().Do()
+	chk.Assert(err, IsNil)
+	c := res.Result.Consents
+	chk.Assert(c, HasLen, 4)
+	chk.Check(c[0].DocumentType, Equals, DocumentTermsOfService)
+	chk.Check(c[1].Action, Equals, ConsentAccepted)
+	chk.Check(c[2].DocumentType, Equals, DocumentMarketing)
+	chk.Check(c[2].Action, Equals, ConsentWithdrawn)
+	chk.Check(c[2].SourceIP, Equals, "198.51.100.2")
+	chk.Check(c[2].RecordedAt.Equal(time.Date(2016, 9, 10, 0, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(c[3].DocumentType, Equals, DocumentType("data_processing_agreement"))
+	chk.Check(c[3].SourceIP, Equals, "")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Consents_Accept(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/consents")
+		var body map[string]string
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		chk.Check(body, DeepEquals, map[string]string{"document_type": "privacy_policy", "version": "2017-02"})
+		fmt.Fprint(w, `{"code":0,"result":{"document_type":"privacy_policy","version":"2017-02","action":"accepted",
+			"recorded_at":"2017-02-23T08:00:00Z","source_ip":"203.0.113.7"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Consents.Accept(DocumentPrivacyPolicy, "2017-02").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Action, Equals, ConsentAccepted)
+	chk.Check(res.Result.Version, Equals, "2017-02")
+
+	_, err = svc.Me.Consents.Accept(DocumentPrivacyPolicy, "").Do()
+	chk.Check(err, NotNil)
+}
@@ -0,0 +1,80 @@
+package account
+
+import (
+	"net/http"
+	"strings"
+)
+
+// countryCodes holds the officially assigned ISO 3166-1 alpha-2 codes.
+var countryCodes = map[string]bool{}
+
+func init() {
+	for _, code := range strings.Fields(iso3166) {
+		countryCodes[code] = true
+	}
+}
+
+const iso3166 = "" +
+	"AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE " +
+	"BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ CA CC CD " +
+	"CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ DE DJ DK DM " +
+	"DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR GA GB GD GE GF " +
+	"GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM HN HR HT HU " +
+	"ID IE IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN " +
+	"KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME " +
+	"MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ NA " +
+	"NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM " +
+	"PN PR PS PT PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI " +
+	"SJ SK SL SM SN SO SR SS ST SV SX SY SZ TC TD TF TG TH TJ TK " +
+	"TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI " +
+	"VN VU WF WS YE YT ZA ZM ZW"
+
+// IsCountryCode reports whether code is an assigned ISO 3166-1 alpha-2
+// country code, in upper case.
+func IsCountryCode(code string) bool {
+	return countryCodes[code]
+}
+
+// A Country is a country or region supported by the API.
+type Country struct {
+	Code string `json:"code"`
+
+	// Name is the display name in the requested language.
+	Name string `json:"name"`
+}
+
+type CountryListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Countries []*Country `json:"countries"`
+	} `json:"result"`
+}
+
+type UserCountriesCall struct {
+	s      *Service
+	header http.Header
+}
+
+// Countries lists the countries and regions an account can be set to.
+func (r *UserService) Countries() *UserCountriesCall {
+	c := &UserCountriesCall{s: r.s, header: http.Header{}}
+	return c
+}
+
+// Language sets the Accept-Language header selecting the language of the
+// display names, e.g. "zh-TW".
+func (c *UserCountriesCall) Language(lang string) *UserCountriesCall {
+	c.header.Set("Accept-Language", lang)
+	return c
+}
+
+func (c *UserCountriesCall) Do() (*CountryListResponse, error) {
+	path := versioned("countries")
+	ret := &CountryListResponse{}
+	_, err := c.s.getWithHeader(path, c.header, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,65 @@
+package account
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Me_Country(chk *C) {
+	me := &GetUserResponse{}
+	chk.Assert(json.Unmarshal([]byte(`{"result":{"country":"TW"}}`), me), IsNil)
+	chk.Check(me.Result.Country, Equals, "TW")
+
+	chk.Check(IsCountryCode("TW"), Equals, true)
+	chk.Check(IsCountryCode("tw"), Equals, false)
+	chk.Check(IsCountryCode("XX"), Equals, false)
+	chk.Check(countryCodes, HasLen, 249)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Me_Update_Country(chk *C) {
+	var body string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		fmt.Fprint(w, `{"code":0,"result":{"country":"JP"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Update().Country("jp").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(body, Equals, `{"country":"JP"}`+"\n")
+	chk.Check(res.Result.Country, Equals, "JP")
+
+	body = ""
+	for _, code := range []string{"XX", "JPN", ""} {
+		_, err = svc.Me.Update().Country(code).Do()
+		chk.Check(err, ErrorMatches, "account: invalid country code .*")
+	}
+	chk.Check(body, Equals, "")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_Countries(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/countries")
+		switch r.Header.Get("Accept-Language") {
+		case "zh-TW":
+			fmt.Fprint(w, `{"code":0,"result":{"countries":[{"code":"TW","name":"臺灣"},{"code":"JP","name":"日本"}]}}`)
+		default:
+			fmt.Fprint(w, `{"code":0,"result":{"countries":[{"code":"TW","name":"Taiwan"},{"code":"JP","name":"Japan"}]}}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.User.Countries().Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(res.Result.Countries, HasLen, 2)
+	chk.Check(*res.Result.Countries[1], Equals, Country{Code: "JP", Name: "Japan"})
+
+	res, err = svc.User.Countries().Language("zh-TW").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(*res.Result.Countries[0], Equals, Country{Code: "TW", Name: "臺灣"})
+}
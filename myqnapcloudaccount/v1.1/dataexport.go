@@ -0,0 +1,145 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"time"
+)
+
+// ErrExportExpired is returned, or matched by errors.Is, when a data
+// export is no longer available for download.
+var ErrExportExpired = errors.New("account: data export expired")
+
+const codeExportExpired = 41002
+
+// DataExportState is the preparation state of a data export.
+type DataExportState string
+
+const (
+	DataExportPending DataExportState = "pending"
+	DataExportReady   DataExportState = "ready"
+	DataExportExpired DataExportState = "expired"
+)
+
+type DataExportService struct {
+	s *Service
+}
+
+func NewDataExportService(s *Service) *DataExportService {
+	rs := &DataExportService{s: s}
+	return rs
+}
+
+type DataExportRequestResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		ExportID string `json:"export_id"`
+	} `json:"result"`
+}
+
+type DataExportRequestCall struct {
+	s *Service
+}
+
+// Request asks for an archive of all the data held about the account.
+// The archive is prepared asynchronously; see Status and WaitReady.
+func (r *DataExportService) Request() *DataExportRequestCall {
+	c := &DataExportRequestCall{s: r.s}
+	return c
+}
+
+func (c *DataExportRequestCall) Do() (*DataExportRequestResponse, error) {
+	path := versioned("me/data-exports")
+	ret := &DataExportRequestResponse{}
+	_, err := c.s.post(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type DataExportStatusResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		ExportID string          `json:"export_id"`
+		State    DataExportState `json:"state"`
+
+		// ExpiresAt is when a ready export stops being downloadable.
+		ExpiresAt time.Time `json:"expires_at"`
+	} `json:"result"`
+}
+
+type DataExportStatusCall struct {
+	s   *Service
+	ctx context.Context
+	id  string
+}
+
+// Status retrieves the preparation state of an export.
+func (r *DataExportService) Status(exportID string) *DataExportStatusCall {
+	c := &DataExportStatusCall{s: r.s, ctx: context.Background(), id: exportID}
+	return c
+}
+
+// Context sets the context of the call.
+func (c *DataExportStatusCall) Context(ctx context.Context) *DataExportStatusCall {
+	c.ctx = ctx
+	return c
+}
+
+func (c *DataExportStatusCall) Do() (*DataExportStatusResponse, error) {
+	path := versioned("me/data-exports/" + url.PathEscape(c.id))
+	ret := &DataExportStatusResponse{}
+	_, err := c.s.getContext(c.ctx, path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// WaitReady polls the state of an export until it is ready. It returns
+// ErrExportExpired if the export expired first.
+func (r *DataExportService) WaitReady(ctx context.Context, exportID string, opts ...WaitOption) (*DataExportStatusResponse, error) {
+	var ret *DataExportStatusResponse
+	err := WaitFor(ctx, func() (bool, error) {
+		res, err := r.Status(exportID).Context(ctx).Do()
+		if err != nil {
+			return false, err
+		}
+		ret = res
+		switch res.Result.State {
+		case DataExportReady:
+			return true, nil
+		case DataExportExpired:
+			return false, ErrExportExpired
+		}
+		return false, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type DataExportDownloadCall struct {
+	s   *Service
+	ctx context.Context
+	id  string
+	w   io.Writer
+}
+
+// Download writes the ZIP archive of a ready export to w.
+func (r *DataExportService) Download(ctx context.Context, exportID string, w io.Writer) *DataExportDownloadCall {
+	c := &DataExportDownloadCall{s: r.s, ctx: ctx, id: exportID, w: w}
+	return c
+}
+
+func (c *DataExportDownloadCall) Do() error {
+	path := versioned("me/data-exports/" + url.PathEscape(c.id) + "/download")
+	_, err := c.s.download(c.ctx, path, "application/zip", c.w)
+	return err
+}
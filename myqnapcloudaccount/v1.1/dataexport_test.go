@@ -0,0 +1,86 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+var dataExportZip = []byte("PK\x03\x04\x14\x00\x00\x00\x08\x00fake-archive\x00\xff")
+
+// fakeDataExportAPI prepares an export that becomes ready after two
+// polls, and expires when expire is set.
+func fakeDataExportAPI(chk *C, expire *bool) http.HandlerFunc {
+	polls := 0
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v1.1/me/data-exports":
+			fmt.Fprint(w, `{"code":0,"result":{"export_id":"x1"}}`)
+		case "GET /v1.1/me/data-exports/x1":
+			polls++
+			state := "pending"
+			switch {
+			case *expire:
+				state = "expired"
+			case polls >= 2:
+				state = "ready"
+			}
+			fmt.Fprintf(w, `{"code":0,"result":{"export_id":"x1","state":%q,"expires_at":"2017-03-02T00:00:00Z"}}`, state)
+		case "GET /v1.1/me/data-exports/x1/download":
+			if *expire {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusGone)
+				fmt.Fprint(w, `{"code":41002,"message":"export expired"}`)
+				return
+			}
+			chk.Check(r.Header.Get("Accept"), Equals, "application/zip")
+			w.Header().Set("Content-Type", "application/zip")
+			w.Write(dataExportZip)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_DataExport_Lifecycle(chk *C) {
+	expire := false
+	svc, ts := newFakeService(fakeDataExportAPI(chk, &expire))
+	defer ts.Close()
+
+	req, err := svc.Me.DataExport.Request().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(req.Result.ExportID, Equals, "x1")
+
+	st, err := svc.Me.DataExport.Status("x1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(st.Result.State, Equals, DataExportPending)
+
+	st, err = svc.Me.DataExport.WaitReady(context.Background(), "x1", WaitInterval(time.Millisecond))
+	chk.Assert(err, IsNil)
+	chk.Check(st.Result.State, Equals, DataExportReady)
+	chk.Check(st.Result.ExpiresAt.Equal(time.Date(2017, 3, 2, 0, 0, 0, 0, time.UTC)), Equals, true)
+
+	buf := &bytes.Buffer{}
+	err = svc.Me.DataExport.Download(context.Background(), "x1", buf).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(buf.Bytes(), DeepEquals, dataExportZip)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_DataExport_Expired(chk *C) {
+	expire := true
+	svc, ts := newFakeService(fakeDataExportAPI(chk, &expire))
+	defer ts.Close()
+
+	_, err := svc.Me.DataExport.WaitReady(context.Background(), "x1", WaitInterval(time.Millisecond))
+	chk.Check(err, Equals, ErrExportExpired)
+
+	buf := &bytes.Buffer{}
+	err = svc.Me.DataExport.Download(context.Background(), "x1", buf).Do()
+	chk.Check(errors.Is(err, ErrExportExpired), Equals, true)
+	chk.Check(buf.Len(), Equals, 0)
+}
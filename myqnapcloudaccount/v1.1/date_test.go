@@ -0,0 +1,51 @@
+package account
+
+import (
+	"encoding/json"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Date_RoundTrip(chk *C) {
+	for _, str := range []string{"1985-07-09", "2000-02-29", "0001-01-02"} {
+		d, err := ParseDate(str)
+		chk.Assert(err, IsNil)
+		chk.Check(d.String(), Equals, str)
+
+		b, err := json.Marshal(d)
+		chk.Assert(err, IsNil)
+		chk.Check(string(b), Equals, `"`+str+`"`)
+
+		var back Date
+		chk.Assert(json.Unmarshal(b, &back), IsNil)
+		chk.Check(back, Equals, d)
+	}
+
+	d, err := ParseDate("1985-07-09")
+	chk.Assert(err, IsNil)
+	chk.Check(d, Equals, Date{1985, time.July, 9})
+	chk.Check(DateOf(time.Date(1985, 7, 9, 23, 59, 0, 0, time.UTC)), Equals, d)
+	chk.Check(d.Before(Date{1985, time.July, 10}), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Date_Invalid(chk *C) {
+	for _, str := range []string{"1985-7-9", "2001-02-29", "09/07/1985", "1985-07-09T00:00:00Z"} {
+		_, err := ParseDate(str)
+		chk.Check(err, NotNil, Commentf(str))
+
+		var d Date
+		chk.Check(json.Unmarshal([]byte(`"`+str+`"`), &d), NotNil)
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Date_Zero(chk *C) {
+	for _, v := range []string{`""`, `null`} {
+		d := Date{2000, 1, 1}
+		chk.Assert(json.Unmarshal([]byte(v), &d), IsNil)
+		chk.Check(d.IsZero(), Equals, true)
+	}
+	b, err := json.Marshal(Date{})
+	chk.Assert(err, IsNil)
+	chk.Check(string(b), Equals, `""`)
+}
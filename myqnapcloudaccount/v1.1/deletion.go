@@ -0,0 +1,96 @@
+package account
+
+import (
+	"errors"
+	"time"
+)
+
+const codeDeletionPending = 40905
+
+type DeletionResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Pending is set while a deletion is scheduled.
+		Pending     bool      `json:"pending"`
+		ScheduledAt time.Time `json:"scheduled_at"`
+
+		// EffectiveAt is the end of the grace period, when the account
+		// is deleted unless the deletion is cancelled.
+		EffectiveAt time.Time `json:"effective_at"`
+	} `json:"result"`
+}
+
+type DeletionService struct {
+	s *Service
+}
+
+func NewDeletionService(s *Service) *DeletionService {
+	rs := &DeletionService{s: s}
+	return rs
+}
+
+type DeletionScheduleCall struct {
+	s *Service
+}
+
+// Schedule schedules the deletion of the account at the end of a grace
+// period. If a deletion is already scheduled, it is returned unchanged.
+func (r *DeletionService) Schedule() *DeletionScheduleCall {
+	c := &DeletionScheduleCall{s: r.s}
+	return c
+}
+
+func (c *DeletionScheduleCall) Do() (*DeletionResponse, error) {
+	path := versioned("me/deletion")
+	ret := &DeletionResponse{}
+	_, err := c.s.post(path, nil, ret)
+	if err != nil {
+		var e *ErrorResponse
+		if errors.As(err, &e) && e.Code == codeDeletionPending {
+			return (&DeletionStatusCall{s: c.s}).Do()
+		}
+		return nil, err
+	}
+	return ret, nil
+}
+
+type DeletionStatusCall struct {
+	s *Service
+}
+
+// Status retrieves the scheduled deletion of the account, if any.
+func (r *DeletionService) Status() *DeletionStatusCall {
+	c := &DeletionStatusCall{s: r.s}
+	return c
+}
+
+func (c *DeletionStatusCall) Do() (*DeletionResponse, error) {
+	path := versioned("me/deletion")
+	ret := &DeletionResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type DeletionCancelCall struct {
+	s *Service
+}
+
+// Cancel cancels the scheduled deletion of the account.
+func (r *DeletionService) Cancel() *DeletionCancelCall {
+	c := &DeletionCancelCall{s: r.s}
+	return c
+}
+
+func (c *DeletionCancelCall) Do() (*DeletionResponse, error) {
+	path := versioned("me/deletion")
+	ret := &DeletionResponse{}
+	_, err := c.s.delete(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
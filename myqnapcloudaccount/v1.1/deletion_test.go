@@ -0,0 +1,73 @@
+package account
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+// fakeDeletionAPI keeps the deletion schedule of one account.
+func fakeDeletionAPI(chk *C) http.HandlerFunc {
+	pending := false
+	schedules := 0
+	state := func(w http.ResponseWriter) {
+		if !pending {
+			fmt.Fprint(w, `{"code":0,"result":{"pending":false}}`)
+			return
+		}
+		fmt.Fprintf(w, `{"code":0,"result":{"pending":true,"scheduled_at":"2017-02-0%dT00:00:00Z","effective_at":"2017-03-0%dT00:00:00Z"}}`,
+			schedules, schedules)
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/deletion")
+		switch r.Method {
+		case "POST":
+			if pending {
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprint(w, `{"code":40905,"message":"deletion already scheduled"}`)
+				return
+			}
+			pending = true
+			schedules++
+			state(w)
+		case "GET":
+			state(w)
+		case "DELETE":
+			pending = false
+			state(w)
+		}
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Deletion(chk *C) {
+	svc, ts := newFakeService(fakeDeletionAPI(chk))
+	defer ts.Close()
+
+	st, err := svc.Me.Deletion.Status().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(st.Result.Pending, Equals, false)
+
+	sch, err := svc.Me.Deletion.Schedule().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(sch.Result.Pending, Equals, true)
+	chk.Check(sch.Result.EffectiveAt.Equal(time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC)), Equals, true)
+
+	st, err = svc.Me.Deletion.Status().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(st.Result, Equals, sch.Result)
+
+	again, err := svc.Me.Deletion.Schedule().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(again.Result, Equals, sch.Result)
+
+	cancelled, err := svc.Me.Deletion.Cancel().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(cancelled.Result.Pending, Equals, false)
+
+	st, err = svc.Me.Deletion.Status().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(st.Result.Pending, Equals, false)
+	chk.Check(st.Result.EffectiveAt.IsZero(), Equals, true)
+}
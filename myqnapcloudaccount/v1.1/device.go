@@ -0,0 +1,509 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrRegistrationCodeExpired is matched by errors.Is when registering a
+	// device with an expired registration code.
+	ErrRegistrationCodeExpired = errors.New("account: registration code expired")
+
+	// ErrRegistrationCodeUsed is matched by errors.Is when registering a
+	// device with a registration code used already.
+	ErrRegistrationCodeUsed = errors.New("account: registration code already used")
+
+	// ErrDeviceLimit is matched by errors.Is when the account has as many
+	// devices as it may have.
+	ErrDeviceLimit = errors.New("account: device limit reached")
+
+	// ErrHostnameTaken is matched by errors.Is when the requested
+	// myQNAPcloud hostname belongs to another device.
+	ErrHostnameTaken = errors.New("account: hostname already in use")
+)
+
+const (
+	codeRegistrationCodeExpired = 41003
+	codeRegistrationCodeUsed    = 40915
+	codeDeviceLimit             = 40916
+	codeHostnameTaken           = 40917
+)
+
+const maxDeviceName = 14
+
+// validateDeviceName applies the device name rules of the API: 1 to 14
+// ASCII letters, digits and '-', not starting or ending with '-'.
+func validateDeviceName(name string) error {
+	return validateLabel("device name", name, maxDeviceName, "-")
+}
+
+const maxHostname = 63
+
+// validateHostname checks that hostname is a valid DNS label: 1 to 63
+// ASCII letters, digits and '-', not starting or ending with '-'.
+func validateHostname(hostname string) error {
+	return validateLabel("hostname", hostname, maxHostname, "-")
+}
+
+// validateLabel checks that s is 1 to max ASCII letters, digits and
+// characters of punct, not starting or ending with one of punct. what
+// names s in the errors.
+func validateLabel(what, s string, max int, punct string) error {
+	if n := len(s); n < 1 || n > max {
+		return fmt.Errorf("account: %s must be 1 to %d characters long", what, max)
+	}
+	for _, r := range []rune{rune(s[0]), rune(s[len(s)-1])} {
+		if strings.ContainsRune(punct, r) {
+			return fmt.Errorf("account: %s %q starts or ends with %q", what, s, r)
+		}
+	}
+	for _, r := range s {
+		if !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9' || strings.ContainsRune(punct, r)) {
+			return fmt.Errorf("account: %s %q contains invalid character %q", what, s, r)
+		}
+	}
+	return nil
+}
+
+// HostnameSuggestions returns the available hostnames suggested by the
+// API when setting a hostname failed with ErrHostnameTaken.
+func HostnameSuggestions(err error) ([]string, bool) {
+	var e *ErrorResponse
+	if !errors.As(err, &e) || e.Code != codeHostnameTaken || len(e.Details) == 0 {
+		return nil, false
+	}
+	var details struct {
+		Suggestions []string `json:"suggestions"`
+	}
+	if json.Unmarshal(e.Details, &details) != nil || len(details.Suggestions) == 0 {
+		return nil, false
+	}
+	return details.Suggestions, true
+}
+
+// DeviceRole is the role of a user on a device. Roles unknown to this
+// package are kept verbatim.
+type DeviceRole string
+
+const (
+	DeviceOwner DeviceRole = "owner"
+	DeviceAdmin DeviceRole = "admin"
+	DeviceUser  DeviceRole = "user"
+	DeviceGuest DeviceRole = "guest"
+)
+
+// A Device is a NAS registered to the account.
+type Device struct {
+	DeviceID string `json:"device_id"`
+	Name     string `json:"name"`
+	Model    string `json:"model"`
+	Firmware string `json:"firmware"`
+
+	// Hostname is the myQNAPcloud DDNS hostname of the device, such as
+	// "mynas.myqnapcloud.com".
+	Hostname     string    `json:"hostname"`
+	Online       bool      `json:"online"`
+	RegisteredAt time.Time `json:"registered_at"`
+}
+
+type DeviceListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Devices is empty, never nil, when the account has no devices.
+		Devices       []*Device `json:"devices"`
+		NextPageToken string    `json:"next_page_token"`
+	} `json:"result"`
+}
+
+type DeviceListCall struct {
+	s      *Service
+	params url.Values
+}
+
+// List lists the devices registered to the account.
+func (r *DeviceService) List() *DeviceListCall {
+	c := &DeviceListCall{s: r.s, params: url.Values{}}
+	return c
+}
+
+// OnlineOnly restricts the listing to the devices currently online.
+func (c *DeviceListCall) OnlineOnly() *DeviceListCall {
+	c.params.Set("online", "true")
+	return c
+}
+
+// Query restricts the listing to the devices whose name, hostname or
+// model matches q.
+func (c *DeviceListCall) Query(q string) *DeviceListCall {
+	c.params.Set("q", q)
+	return c
+}
+
+// PageSize sets the maximum number of devices returned per page.
+func (c *DeviceListCall) PageSize(n int) *DeviceListCall {
+	c.params.Set("page_size", strconv.Itoa(n))
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *DeviceListCall) PageToken(token string) *DeviceListCall {
+	if token == "" {
+		c.params.Del("page_token")
+		return c
+	}
+	c.params.Set("page_token", token)
+	return c
+}
+
+func (c *DeviceListCall) Do() (*DeviceListResponse, error) {
+	return c.doContext(context.Background())
+}
+
+func (c *DeviceListCall) doContext(ctx context.Context) (*DeviceListResponse, error) {
+	path := versioned("devices")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &DeviceListResponse{}
+	_, err := c.s.getContext(ctx, path, ret)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Result.Devices == nil {
+		ret.Result.Devices = []*Device{}
+	}
+	return ret, nil
+}
+
+// Pages calls f for each page of the listing, from the page set with
+// PageToken on; see pages for the details.
+func (c *DeviceListCall) Pages(ctx context.Context, f func(*DeviceListResponse) error) error {
+	return pages(ctx, c.params, func() (string, error) {
+		res, err := c.doContext(ctx)
+		if err != nil {
+			return "", err
+		}
+		return res.Result.NextPageToken, f(res)
+	})
+}
+
+// A DeviceNetwork holds the addresses of a device.
+type DeviceNetwork struct {
+	LANIP string `json:"lan_ip"`
+	WANIP string `json:"wan_ip"`
+}
+
+// A NASService is a network service of a device, such as "web" or "ssh".
+type NASService struct {
+	Name    string `json:"name"`
+	Port    int    `json:"port"`
+	Enabled bool   `json:"enabled"`
+
+	// Access is who may reach the service through myQNAPcloud. It is
+	// only returned by Services.
+	Access AccessLevel `json:"access,omitempty"`
+}
+
+// A UPnPStatus is the port forwarding status of the router of a device.
+type UPnPStatus struct {
+	Enabled bool `json:"enabled"`
+
+	// Router is the model of the router found by UPnP discovery, empty if
+	// none was found.
+	Router string `json:"router"`
+}
+
+// A DeviceDetail is the full record of a device.
+type DeviceDetail struct {
+	Device
+
+	// Network and UPnP are nil for devices that never connected, such as
+	// devices being registered.
+	Network *DeviceNetwork `json:"network"`
+	UPnP    *UPnPStatus    `json:"upnp"`
+
+	// Services is nil when the device never reported its services.
+	Services      []*NASService `json:"services"`
+	LastHeartbeat time.Time     `json:"last_heartbeat"`
+}
+
+type DeviceGetResponse struct {
+	Message string       `json:"message"`
+	Code    int          `json:"code"`
+	Result  DeviceDetail `json:"result"`
+}
+
+type DeviceGetCall struct {
+	s  *Service
+	id string
+}
+
+// Get retrieves the detail record of a device. Devices not registered to
+// the account yield an error matching ErrNotFound.
+func (r *DeviceService) Get(deviceID string) *DeviceGetCall {
+	c := &DeviceGetCall{s: r.s, id: deviceID}
+	return c
+}
+
+func (c *DeviceGetCall) Do() (*DeviceGetResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id))
+	ret := &DeviceGetResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type DeviceRegisterResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		DeviceID string `json:"device_id"`
+		Hostname string `json:"hostname"`
+	} `json:"result"`
+}
+
+type DeviceRegisterCall struct {
+	s    *Service
+	code string
+	name string
+}
+
+// Register registers the device showing the registration code to the
+// account, under the given name.
+func (r *DeviceService) Register(code string, name string) *DeviceRegisterCall {
+	c := &DeviceRegisterCall{s: r.s, code: code, name: name}
+	return c
+}
+
+func (c *DeviceRegisterCall) Do() (*DeviceRegisterResponse, error) {
+	if err := validateDeviceName(c.name); err != nil {
+		return nil, err
+	}
+	path := versioned("devices")
+	payload := struct {
+		Code string `json:"registration_code"`
+		Name string `json:"name"`
+	}{c.code, c.name}
+	ret := &DeviceRegisterResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type DeviceUnregisterResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// SharesRemoved and DDNSRemoved report whether the shares of the
+		// device and its myQNAPcloud DDNS records were removed with it.
+		SharesRemoved bool `json:"shares_removed"`
+		DDNSRemoved   bool `json:"ddns_removed"`
+	} `json:"result"`
+
+	// AlreadyRemoved is set when the device was not registered to the
+	// account anymore.
+	AlreadyRemoved bool `json:"-"`
+}
+
+type DeviceUnregisterCall struct {
+	s       *Service
+	id      string
+	confirm bool
+}
+
+// Unregister removes a device from the account. As this cannot be undone,
+// the call must be confirmed with Confirm, otherwise Do returns
+// ErrNotConfirmed. Unregistering a device shared with the account by
+// another user yields an error matching ErrForbidden.
+func (r *DeviceService) Unregister(deviceID string) *DeviceUnregisterCall {
+	c := &DeviceUnregisterCall{s: r.s, id: deviceID}
+	return c
+}
+
+// Confirm confirms the removal of the device.
+func (c *DeviceUnregisterCall) Confirm() *DeviceUnregisterCall {
+	c.confirm = true
+	return c
+}
+
+func (c *DeviceUnregisterCall) Do() (*DeviceUnregisterResponse, error) {
+	if !c.confirm {
+		return nil, ErrNotConfirmed
+	}
+	path := versioned("devices/"+url.PathEscape(c.id)) + "?confirm=true"
+	ret := &DeviceUnregisterResponse{}
+	_, err := c.s.delete(path, nil, ret)
+	if errors.Is(err, ErrNotFound) {
+		return &DeviceUnregisterResponse{AlreadyRemoved: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type DeviceRenameCall struct {
+	s    *Service
+	id   string
+	name string
+}
+
+// Rename changes the name of a device. Its myQNAPcloud hostname stays
+// unchanged; see SetHostname.
+func (r *DeviceService) Rename(deviceID, name string) *DeviceRenameCall {
+	c := &DeviceRenameCall{s: r.s, id: deviceID, name: name}
+	return c
+}
+
+func (c *DeviceRenameCall) Do() (*DeviceGetResponse, error) {
+	if err := validateDeviceName(c.name); err != nil {
+		return nil, err
+	}
+	path := versioned("devices/" + url.PathEscape(c.id))
+	ret := &DeviceGetResponse{}
+	_, err := c.s.patch(path, patch{"name": c.name}, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type DeviceSetHostnameResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Hostname is the full hostname of the device, such as
+		// "mynas.myqnapcloud.com".
+		Hostname string `json:"hostname"`
+	} `json:"result"`
+}
+
+type DeviceSetHostnameCall struct {
+	s        *Service
+	id       string
+	hostname string
+}
+
+// SetHostname changes the myQNAPcloud hostname of a device. hostname is
+// the label before ".myqnapcloud.com". When it belongs to another device,
+// the returned error matches ErrHostnameTaken; see HostnameSuggestions.
+func (r *DeviceService) SetHostname(deviceID, hostname string) *DeviceSetHostnameCall {
+	c := &DeviceSetHostnameCall{s: r.s, id: deviceID, hostname: hostname}
+	return c
+}
+
+func (c *DeviceSetHostnameCall) Do() (*DeviceSetHostnameResponse, error) {
+	if err := validateHostname(c.hostname); err != nil {
+		return nil, err
+	}
+	path := versioned("devices/" + url.PathEscape(c.id) + "/hostname")
+	payload := struct {
+		Hostname string `json:"hostname"`
+	}{c.hostname}
+	ret := &DeviceSetHostnameResponse{}
+	_, err := c.s.put(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// MaxBatchUnregister is the maximum number of devices the API accepts per
+// batch removal request. BatchUnregister splits larger batches.
+const MaxBatchUnregister = 25
+
+// An UnregisterResult is the outcome of removing one device of a batch.
+type UnregisterResult struct {
+	DeviceID      string `json:"device_id"`
+	SharesRemoved bool   `json:"shares_removed"`
+	DDNSRemoved   bool   `json:"ddns_removed"`
+
+	// Err is nil if the device was removed.
+	Err *ItemError `json:"error"`
+}
+
+type DeviceBatchUnregisterResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Results holds an entry per device, in the order of the input.
+		// When Do fails part way, the entries of the devices not sent
+		// yet are nil.
+		Results []*UnregisterResult `json:"results"`
+	} `json:"result"`
+}
+
+type DeviceBatchUnregisterCall struct {
+	s       *Service
+	ids     []string
+	confirm bool
+}
+
+// BatchUnregister removes several devices from the account at once.
+// Devices that cannot be removed, such as devices being transferred, are
+// reported in the per-device results rather than failing the call. As
+// for Unregister, the call must be confirmed with Confirm, otherwise Do
+// returns ErrNotConfirmed.
+//
+// Batches larger than MaxBatchUnregister are sent in several requests. If
+// one of them fails, Do returns the error along with the results of the
+// requests that succeeded before it, whose devices are removed.
+func (r *DeviceService) BatchUnregister(deviceIDs []string) *DeviceBatchUnregisterCall {
+	c := &DeviceBatchUnregisterCall{s: r.s, ids: deviceIDs}
+	return c
+}
+
+// Confirm confirms the removal of the devices.
+func (c *DeviceBatchUnregisterCall) Confirm() *DeviceBatchUnregisterCall {
+	c.confirm = true
+	return c
+}
+
+func (c *DeviceBatchUnregisterCall) Do() (*DeviceBatchUnregisterResponse, error) {
+	if !c.confirm {
+		return nil, ErrNotConfirmed
+	}
+	path := versioned("devices/batch-unregister")
+	results := make([]*UnregisterResult, 0, len(c.ids))
+	ret := &DeviceBatchUnregisterResponse{}
+	for len(results) < len(c.ids) {
+		chunk := c.ids[len(results):]
+		if len(chunk) > MaxBatchUnregister {
+			chunk = chunk[:MaxBatchUnregister]
+		}
+		payload := struct {
+			DeviceIDs []string `json:"device_ids"`
+			Confirm   bool     `json:"confirm"`
+		}{chunk, true}
+		res := &DeviceBatchUnregisterResponse{}
+		_, err := c.s.post(path, payload, res)
+		if err == nil && len(res.Result.Results) != len(chunk) {
+			err = fmt.Errorf("account: batch removal returned %d results for %d devices", len(res.Result.Results), len(chunk))
+		}
+		if err != nil {
+			ret.Result.Results = make([]*UnregisterResult, len(c.ids))
+			copy(ret.Result.Results, results)
+			return ret, err
+		}
+		for i, r := range res.Result.Results {
+			r.DeviceID = chunk[i]
+			results = append(results, r)
+		}
+		ret = res
+	}
+	ret.Result.Results = results
+	return ret, nil
+}
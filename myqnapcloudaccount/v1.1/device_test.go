@@ -0,0 +1,380 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_List(chk *C) {
+	var tokens []string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/devices")
+		chk.Check(r.URL.Query().Get("online"), Equals, "true")
+		token := r.URL.Query().Get("page_token")
+		tokens = append(tokens, token)
+		if token == "" {
+			fmt.Fprint(w, `{"code":0,"result":{"devices":[
+				{"device_id":"d1","name":"Home","model":"TS-453A","firmware":"4.2.3",
+				 "hostname":"home.myqnapcloud.com","online":true,"registered_at":"2016-11-02T08:00:00Z"}
+			],"next_page_token":"c2"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"code":0,"result":{"devices":[{"device_id":"d2","name":"Office","online":true}]}}`)
+	})
+	defer ts.Close()
+
+	var devices []*Device
+	err := svc.Device.List().OnlineOnly().Pages(context.Background(), func(res *DeviceListResponse) error {
+		devices = append(devices, res.Result.Devices...)
+		return nil
+	})
+	chk.Assert(err, IsNil)
+	chk.Check(tokens, DeepEquals, []string{"", "c2"})
+	chk.Assert(devices, HasLen, 2)
+	d := devices[0]
+	chk.Check(d.DeviceID, Equals, "d1")
+	chk.Check(d.Model, Equals, "TS-453A")
+	chk.Check(d.Firmware, Equals, "4.2.3")
+	chk.Check(d.Hostname, Equals, "home.myqnapcloud.com")
+	chk.Check(d.Online, Equals, true)
+	chk.Check(d.RegisteredAt.Equal(time.Date(2016, 11, 2, 8, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(devices[1].Name, Equals, "Office")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_List_Empty(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.RawQuery, Equals, "")
+		fmt.Fprint(w, `{"code":0,"result":{}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.List().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Devices, NotNil)
+	chk.Check(res.Result.Devices, HasLen, 0)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_List_Query(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/devices")
+		chk.Check(r.URL.RawQuery, Equals, "online=true&page_size=20&page_token=c2&q=TS-453+%26+lab%2F2")
+		chk.Check(r.URL.Query().Get("q"), Equals, "TS-453 & lab/2")
+		fmt.Fprint(w, `{"code":0,"result":{"devices":[]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.List().Query("TS-453 & lab/2").OnlineOnly().PageSize(20).PageToken("c2").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Devices, NotNil)
+	chk.Check(res.Result.Devices, HasLen, 0)
+	chk.Check(res.Result.NextPageToken, Equals, "")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_Get(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		switch r.URL.Path {
+		case "/v1.1/devices/d1":
+			fmt.Fprint(w, `{"code":0,"result":{"device_id":"d1","name":"Home","model":"TS-453A",
+				"hostname":"home.myqnapcloud.com","online":true,
+				"network":{"lan_ip":"192.168.1.20","wan_ip":"203.0.113.7"},
+				"services":[{"name":"web","port":8080,"enabled":true},{"name":"ssh","port":22,"enabled":false}],
+				"upnp":{"enabled":true,"router":"RT-AC68U"},
+				"last_heartbeat":"2017-02-23T08:00:00Z"}}`)
+		case "/v1.1/devices/d2":
+			fmt.Fprint(w, `{"code":0,"result":{"device_id":"d2","name":"New","online":false}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":40400,"message":"device not found"}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.Get("d1").Do()
+	chk.Assert(err, IsNil)
+	d := res.Result
+	chk.Check(d.Name, Equals, "Home")
+	chk.Assert(d.Network, NotNil)
+	chk.Check(*d.Network, Equals, DeviceNetwork{LANIP: "192.168.1.20", WANIP: "203.0.113.7"})
+	chk.Assert(d.Services, HasLen, 2)
+	chk.Check(*d.Services[0], Equals, NASService{Name: "web", Port: 8080, Enabled: true})
+	chk.Check(d.Services[1].Enabled, Equals, false)
+	chk.Assert(d.UPnP, NotNil)
+	chk.Check(*d.UPnP, Equals, UPnPStatus{Enabled: true, Router: "RT-AC68U"})
+	chk.Check(d.LastHeartbeat.Equal(time.Date(2017, 2, 23, 8, 0, 0, 0, time.UTC)), Equals, true)
+
+	res, err = svc.Device.Get("d2").Do()
+	chk.Assert(err, IsNil)
+	d = res.Result
+	chk.Check(d.Network, IsNil)
+	chk.Check(d.UPnP, IsNil)
+	chk.Check(d.Services, IsNil)
+	chk.Check(d.LastHeartbeat.IsZero(), Equals, true)
+
+	_, err = svc.Device.Get("d3").Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_Register(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/devices")
+		var body map[string]string
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		chk.Check(body["name"], Equals, "Home-NAS")
+		switch body["registration_code"] {
+		case "OK1234":
+			fmt.Fprint(w, `{"code":0,"result":{"device_id":"d9","hostname":"home-nas.myqnapcloud.com"}}`)
+		case "OLD123":
+			w.WriteHeader(http.StatusGone)
+			fmt.Fprint(w, `{"code":41003,"message":"registration code expired"}`)
+		case "USED12":
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"code":40915,"message":"registration code already used"}`)
+		default:
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"code":40916,"message":"device limit reached"}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.Register("OK1234", "Home-NAS").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.DeviceID, Equals, "d9")
+	chk.Check(res.Result.Hostname, Equals, "home-nas.myqnapcloud.com")
+
+	_, err = svc.Device.Register("OLD123", "Home-NAS").Do()
+	chk.Check(errors.Is(err, ErrRegistrationCodeExpired), Equals, true)
+	_, err = svc.Device.Register("USED12", "Home-NAS").Do()
+	chk.Check(errors.Is(err, ErrRegistrationCodeUsed), Equals, true)
+	_, err = svc.Device.Register("MANY12", "Home-NAS").Do()
+	chk.Check(errors.Is(err, ErrDeviceLimit), Equals, true)
+	chk.Check(errors.Is(err, ErrRegistrationCodeUsed), Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_Register_InvalidName(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Error("unexpected request")
+	})
+	defer ts.Close()
+
+	for name, msg := range map[string]string{
+		"":                  "account: device name must be 1 to 14 characters long",
+		"ThisNameIsTooLong": "account: device name must be 1 to 14 characters long",
+		"-nas":              `account: device name "-nas" starts or ends with '-'`,
+		"my nas":            `account: device name "my nas" contains invalid character ' '`,
+		"nas_1":             `account: device name "nas_1" contains invalid character '_'`,
+	} {
+		_, err := svc.Device.Register("OK1234", name).Do()
+		chk.Check(err, ErrorMatches, msg)
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_Unregister(chk *C) {
+	removed := false
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "DELETE")
+		chk.Check(r.URL.Query().Get("confirm"), Equals, "true")
+		switch {
+		case r.URL.Path == "/v1.1/devices/d1" && !removed:
+			removed = true
+			fmt.Fprint(w, `{"code":0,"result":{"shares_removed":true,"ddns_removed":true}}`)
+		case r.URL.Path == "/v1.1/devices/d1":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":40400,"message":"device not found"}`)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"code":40300,"message":"not the device owner"}`)
+		}
+	})
+	defer ts.Close()
+
+	_, err := svc.Device.Unregister("d1").Do()
+	chk.Check(errors.Is(err, ErrNotConfirmed), Equals, true)
+	chk.Check(removed, Equals, false)
+
+	res, err := svc.Device.Unregister("d1").Confirm().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.AlreadyRemoved, Equals, false)
+	chk.Check(res.Result.SharesRemoved, Equals, true)
+	chk.Check(res.Result.DDNSRemoved, Equals, true)
+
+	res, err = svc.Device.Unregister("d1").Confirm().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.AlreadyRemoved, Equals, true)
+
+	_, err = svc.Device.Unregister("d2").Confirm().Do()
+	chk.Check(errors.Is(err, ErrForbidden), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_Rename(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "PATCH")
+		chk.Check(r.URL.Path, Equals, "/v1.1/devices/d1")
+		body, _ := io.ReadAll(r.Body)
+		chk.Check(string(body), Equals, `{"name":"Office"}`+"\n")
+		fmt.Fprint(w, `{"code":0,"result":{"device_id":"d1","name":"Office","hostname":"home.myqnapcloud.com"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.Rename("d1", "Office").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Name, Equals, "Office")
+	chk.Check(res.Result.Hostname, Equals, "home.myqnapcloud.com")
+
+	_, err = svc.Device.Rename("d1", "My Office").Do()
+	chk.Check(err, ErrorMatches, `account: device name "My Office" contains invalid character ' '`)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_SetHostname(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "PUT")
+		chk.Check(r.URL.Path, Equals, "/v1.1/devices/d1/hostname")
+		var body map[string]string
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		if body["hostname"] == "taken" {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"code":40917,"message":"hostname taken","details":{"suggestions":["taken1","taken-nas"]}}`)
+			return
+		}
+		fmt.Fprintf(w, `{"code":0,"result":{"hostname":"%s.myqnapcloud.com"}}`, body["hostname"])
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.SetHostname("d1", "my-nas").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Hostname, Equals, "my-nas.myqnapcloud.com")
+
+	_, err = svc.Device.SetHostname("d1", "taken").Do()
+	chk.Check(errors.Is(err, ErrHostnameTaken), Equals, true)
+	suggestions, ok := HostnameSuggestions(err)
+	chk.Check(ok, Equals, true)
+	chk.Check(suggestions, DeepEquals, []string{"taken1", "taken-nas"})
+
+	_, ok = HostnameSuggestions(ErrHostnameTaken)
+	chk.Check(ok, Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_SetHostname_Invalid(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Error("unexpected request")
+	})
+	defer ts.Close()
+
+	for hostname, msg := range map[string]string{
+		"":                      "account: hostname must be 1 to 63 characters long",
+		strings.Repeat("a", 64): "account: hostname must be 1 to 63 characters long",
+		"nas-":                  `account: hostname "nas-" starts or ends with '-'`,
+		"my.nas":                `account: hostname "my.nas" contains invalid character '.'`,
+		"nas_1":                 `account: hostname "nas_1" contains invalid character '_'`,
+	} {
+		_, err := svc.Device.SetHostname("d1", hostname).Do()
+		chk.Check(err, ErrorMatches, regexp.QuoteMeta(msg))
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_BatchUnregister(chk *C) {
+	var chunks [][]string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/devices/batch-unregister")
+		var body struct {
+			DeviceIDs []string `json:"device_ids"`
+			Confirm   bool     `json:"confirm"`
+		}
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		chk.Check(body.Confirm, Equals, true)
+		chunks = append(chunks, body.DeviceIDs)
+		var results []string
+		for _, id := range body.DeviceIDs {
+			if id == "d7" {
+				results = append(results, `{"device_id":"d7","error":{"code":40920,"message":"device being transferred"}}`)
+				continue
+			}
+			results = append(results, fmt.Sprintf(`{"device_id":%q,"shares_removed":true,"ddns_removed":true}`, id))
+		}
+		fmt.Fprintf(w, `{"code":0,"result":{"results":[%s]}}`, strings.Join(results, ","))
+	})
+	defer ts.Close()
+
+	var ids []string
+	for i := 0; i < MaxBatchUnregister+5; i++ {
+		ids = append(ids, fmt.Sprintf("d%d", i))
+	}
+
+	_, err := svc.Device.BatchUnregister(ids).Do()
+	chk.Check(errors.Is(err, ErrNotConfirmed), Equals, true)
+	chk.Check(chunks, HasLen, 0)
+
+	res, err := svc.Device.BatchUnregister(ids).Confirm().Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(chunks, HasLen, 2)
+	chk.Check(chunks[0], DeepEquals, ids[:MaxBatchUnregister])
+	chk.Check(chunks[1], DeepEquals, ids[MaxBatchUnregister:])
+
+	results := res.Result.Results
+	chk.Assert(results, HasLen, len(ids))
+	for i, r := range results {
+		chk.Check(r.DeviceID, Equals, ids[i])
+	}
+	chk.Check(results[6].Err, IsNil)
+	chk.Check(results[6].SharesRemoved, Equals, true)
+	chk.Assert(results[7].Err, NotNil)
+	chk.Check(results[7].Err.Code, Equals, 40920)
+	chk.Check(results[7].DDNSRemoved, Equals, false)
+	chk.Check(results[MaxBatchUnregister+4].Err, IsNil)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_BatchUnregister_ChunkFailure(chk *C) {
+	var chunks int
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			DeviceIDs []string `json:"device_ids"`
+		}
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		chunks++
+		if chunks == 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"code":50000,"message":"internal error"}`)
+			return
+		}
+		var results []string
+		for _, id := range body.DeviceIDs {
+			results = append(results, fmt.Sprintf(`{"device_id":%q,"shares_removed":true}`, id))
+		}
+		fmt.Fprintf(w, `{"code":0,"result":{"results":[%s]}}`, strings.Join(results, ","))
+	})
+	defer ts.Close()
+
+	var ids []string
+	for i := 0; i < 2*MaxBatchUnregister+1; i++ {
+		ids = append(ids, fmt.Sprintf("d%d", i))
+	}
+
+	res, err := svc.Device.BatchUnregister(ids).Confirm().Do()
+	chk.Assert(err, NotNil)
+	chk.Check(chunks, Equals, 2)
+
+	// The devices of the first chunk are removed and still reported; the
+	// others have no result.
+	chk.Assert(res, NotNil)
+	chk.Assert(res.Result.Results, HasLen, len(ids))
+	for i, r := range res.Result.Results {
+		if i >= MaxBatchUnregister {
+			chk.Check(r, IsNil)
+			continue
+		}
+		chk.Check(r.DeviceID, Equals, ids[i])
+		chk.Check(r.SharesRemoved, Equals, true)
+	}
+}
@@ -0,0 +1,243 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ErrAlreadyHasAccess is matched by errors.Is when inviting to a device
+// a user it is shared with already.
+var ErrAlreadyHasAccess = errors.New("account: user already has access to the device")
+
+const (
+	codeNoAccess         = 40411
+	codeAlreadyHasAccess = 40918
+)
+
+// validateGrantRole checks that role can be given to a user a device is
+// shared with.
+func validateGrantRole(role DeviceRole) error {
+	switch role {
+	case DeviceAdmin, DeviceUser, DeviceGuest:
+		return nil
+	}
+	return fmt.Errorf("account: cannot grant role %q", role)
+}
+
+// A DeviceAccess is a user a device is shared with.
+type DeviceAccess struct {
+	UserID      string     `json:"user_id"`
+	DisplayName string     `json:"display_name"`
+	Role        DeviceRole `json:"role"`
+	InvitedAt   time.Time  `json:"invited_at"`
+
+	// Accepted is whether the user accepted the share.
+	Accepted bool `json:"accepted"`
+}
+
+type DeviceAccessListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Users is empty, never nil, when the device is not shared.
+		Users []*DeviceAccess `json:"users"`
+	} `json:"result"`
+}
+
+type DeviceAccessListCall struct {
+	s  *Service
+	id string
+}
+
+// AccessList lists the users a device of the account is shared with.
+func (r *DeviceService) AccessList(deviceID string) *DeviceAccessListCall {
+	c := &DeviceAccessListCall{s: r.s, id: deviceID}
+	return c
+}
+
+func (c *DeviceAccessListCall) Do() (*DeviceAccessListResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/access")
+	ret := &DeviceAccessListResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Result.Users == nil {
+		ret.Result.Users = []*DeviceAccess{}
+	}
+	return ret, nil
+}
+
+type DeviceGrantAccessResponse struct {
+	Message string       `json:"message"`
+	Code    int          `json:"code"`
+	Result  DeviceAccess `json:"result"`
+}
+
+type DeviceGrantAccessCall struct {
+	s      *Service
+	id     string
+	userID string
+	role   DeviceRole
+}
+
+// GrantAccess shares a device with a friend of the account, or changes
+// the role of a user it is shared with already. role cannot be
+// DeviceOwner; see TransferOwnership. Granting access to a user who is
+// not a friend yields an error matching ErrNotFriend.
+func (r *DeviceService) GrantAccess(deviceID, userID string, role DeviceRole) *DeviceGrantAccessCall {
+	c := &DeviceGrantAccessCall{s: r.s, id: deviceID, userID: userID, role: role}
+	return c
+}
+
+func (c *DeviceGrantAccessCall) Do() (*DeviceGrantAccessResponse, error) {
+	if err := validateGrantRole(c.role); err != nil {
+		return nil, err
+	}
+	path := versioned("devices/" + url.PathEscape(c.id) + "/access")
+	payload := struct {
+		UserID string     `json:"user_id"`
+		Role   DeviceRole `json:"role"`
+	}{c.userID, c.role}
+	ret := &DeviceGrantAccessResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type DeviceRevokeAccessCall struct {
+	s      *Service
+	id     string
+	userID string
+}
+
+// RevokeAccess stops sharing a device with a user. Revoking the access of
+// a user the device is not shared with succeeds; the returned error
+// matches ErrNotFound if the device is not registered to the account,
+// and ErrForbidden if the account may not manage its access.
+func (r *DeviceService) RevokeAccess(deviceID, userID string) *DeviceRevokeAccessCall {
+	c := &DeviceRevokeAccessCall{s: r.s, id: deviceID, userID: userID}
+	return c
+}
+
+func (c *DeviceRevokeAccessCall) Do() error {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/access/" + url.PathEscape(c.userID))
+	_, err := c.s.delete(path, nil, nil)
+	var e *ErrorResponse
+	if errors.As(err, &e) && e.Code == codeNoAccess {
+		return nil
+	}
+	return err
+}
+
+// A ShareInvitation is an email invitation to use a device.
+type ShareInvitation struct {
+	InvitationID string     `json:"invitation_id"`
+	Email        string     `json:"email"`
+	Role         DeviceRole `json:"role"`
+	Message      string     `json:"message"`
+	CreatedAt    time.Time  `json:"created_at"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+}
+
+type DeviceShareInviteResponse struct {
+	Message string          `json:"message"`
+	Code    int             `json:"code"`
+	Result  ShareInvitation `json:"result"`
+}
+
+type DeviceShareInviteCall struct {
+	s       *Service
+	id      string
+	email   string
+	role    DeviceRole
+	message string
+}
+
+// ShareInvite invites the owner of an email address to use a device, with
+// the given role. message is optional. The returned error matches
+// ErrAlreadyHasAccess if the device is shared with the invitee already,
+// and ErrResendCooldown if the address was invited too recently; see
+// ResendCooldown.
+func (r *DeviceService) ShareInvite(deviceID, email string, role DeviceRole, message string) *DeviceShareInviteCall {
+	c := &DeviceShareInviteCall{s: r.s, id: deviceID, email: email, role: role, message: message}
+	return c
+}
+
+func (c *DeviceShareInviteCall) Do() (*DeviceShareInviteResponse, error) {
+	if err := validateEmail(c.email); err != nil {
+		return nil, err
+	}
+	if err := validateGrantRole(c.role); err != nil {
+		return nil, err
+	}
+	path := versioned("devices/" + url.PathEscape(c.id) + "/share-invitations")
+	payload := struct {
+		Email   string     `json:"email"`
+		Role    DeviceRole `json:"role"`
+		Message string     `json:"message,omitempty"`
+	}{c.email, c.role, c.message}
+	ret := &DeviceShareInviteResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type DeviceShareInvitationsResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Invitations is empty, never nil, when none is outstanding.
+		Invitations []*ShareInvitation `json:"invitations"`
+	} `json:"result"`
+}
+
+type DeviceShareInvitationsCall struct {
+	s  *Service
+	id string
+}
+
+// ShareInvitations lists the outstanding email invitations to use a
+// device.
+func (r *DeviceService) ShareInvitations(deviceID string) *DeviceShareInvitationsCall {
+	c := &DeviceShareInvitationsCall{s: r.s, id: deviceID}
+	return c
+}
+
+func (c *DeviceShareInvitationsCall) Do() (*DeviceShareInvitationsResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/share-invitations")
+	ret := &DeviceShareInvitationsResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Result.Invitations == nil {
+		ret.Result.Invitations = []*ShareInvitation{}
+	}
+	return ret, nil
+}
+
+type DeviceCancelShareInviteCall struct {
+	s     *Service
+	id    string
+	invID string
+}
+
+// CancelShareInvite cancels an outstanding email invitation to use a
+// device.
+func (r *DeviceService) CancelShareInvite(deviceID, invitationID string) *DeviceCancelShareInviteCall {
+	c := &DeviceCancelShareInviteCall{s: r.s, id: deviceID, invID: invitationID}
+	return c
+}
+
+func (c *DeviceCancelShareInviteCall) Do() error {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/share-invitations/" + url.PathEscape(c.invID))
+	_, err := c.s.delete(path, nil, nil)
+	return err
+}
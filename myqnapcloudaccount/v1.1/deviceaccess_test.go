@@ -0,0 +1,187 @@
+package account
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+// fakeDeviceAccessAPI keeps the users device d1 is shared with in memory.
+// Device d2 belongs to another account.
+func fakeDeviceAccessAPI(chk *C) http.HandlerFunc {
+	roles := map[string]string{}
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/v1.1/devices/d2/"):
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"code":40300,"message":"not the device owner"}`)
+		case !strings.HasPrefix(r.URL.Path, "/v1.1/devices/d1/"):
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":40400,"message":"device not found"}`)
+		case r.Method == "POST" && r.URL.Path == "/v1.1/devices/d1/access":
+			var body map[string]string
+			chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+			if body["user_id"] == "stranger" {
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprint(w, `{"code":40911,"message":"not a friend"}`)
+				return
+			}
+			roles[body["user_id"]] = body["role"]
+			fmt.Fprintf(w, `{"code":0,"result":{"user_id":%q,"role":%q,"invited_at":"2017-02-23T08:00:00Z"}}`, body["user_id"], body["role"])
+		case r.Method == "GET" && r.URL.Path == "/v1.1/devices/d1/access":
+			fmt.Fprint(w, `{"code":0,"result":{"users":[`)
+			if role, ok := roles["u1"]; ok {
+				fmt.Fprintf(w, `{"user_id":"u1","display_name":"Alice","role":%q,"invited_at":"2017-02-23T08:00:00Z","accepted":true}`, role)
+			}
+			fmt.Fprint(w, `]}}`)
+		case r.Method == "DELETE" && r.URL.Path == "/v1.1/devices/d1/access/u1":
+			if _, ok := roles["u1"]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"code":40411,"message":"no access"}`)
+				return
+			}
+			delete(roles, "u1")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			chk.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_Access(chk *C) {
+	svc, ts := newFakeService(fakeDeviceAccessAPI(chk))
+	defer ts.Close()
+
+	granted, err := svc.Device.GrantAccess("d1", "u1", DeviceUser).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(granted.Result.Role, Equals, DeviceUser)
+	chk.Check(granted.Result.Accepted, Equals, false)
+
+	list, err := svc.Device.AccessList("d1").Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(list.Result.Users, HasLen, 1)
+	u := list.Result.Users[0]
+	chk.Check(u.UserID, Equals, "u1")
+	chk.Check(u.DisplayName, Equals, "Alice")
+	chk.Check(u.Role, Equals, DeviceUser)
+	chk.Check(u.Accepted, Equals, true)
+	chk.Check(u.InvitedAt.Equal(time.Date(2017, 2, 23, 8, 0, 0, 0, time.UTC)), Equals, true)
+
+	chk.Assert(svc.Device.RevokeAccess("d1", "u1").Do(), IsNil)
+	chk.Assert(svc.Device.RevokeAccess("d1", "u1").Do(), IsNil)
+
+	list, err = svc.Device.AccessList("d1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(list.Result.Users, NotNil)
+	chk.Check(list.Result.Users, HasLen, 0)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_Access_Errors(chk *C) {
+	svc, ts := newFakeService(fakeDeviceAccessAPI(chk))
+	defer ts.Close()
+
+	_, err := svc.Device.GrantAccess("d1", "stranger", DeviceGuest).Do()
+	chk.Check(errors.Is(err, ErrNotFriend), Equals, true)
+
+	_, err = svc.Device.GrantAccess("d1", "u1", DeviceOwner).Do()
+	chk.Check(err, ErrorMatches, `account: cannot grant role "owner"`)
+
+	_, err = svc.Device.AccessList("d2").Do()
+	chk.Check(errors.Is(err, ErrForbidden), Equals, true)
+	_, err = svc.Device.GrantAccess("d2", "u1", DeviceAdmin).Do()
+	chk.Check(errors.Is(err, ErrForbidden), Equals, true)
+	err = svc.Device.RevokeAccess("d2", "u1").Do()
+	chk.Check(errors.Is(err, ErrForbidden), Equals, true)
+
+	_, err = svc.Device.AccessList("d3").Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+	err = svc.Device.RevokeAccess("d3", "u1").Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+}
+
+// fakeShareInvitationsAPI keeps the email invitations of device d1 in
+// memory.
+func fakeShareInvitationsAPI(chk *C) http.HandlerFunc {
+	invited := map[string]bool{}
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/v1.1/devices/d1/share-invitations":
+			var body map[string]string
+			chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+			switch {
+			case body["email"] == "alice@example.com":
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprint(w, `{"code":40918,"message":"already has access"}`)
+			case invited[body["email"]]:
+				w.WriteHeader(http.StatusTooManyRequests)
+				fmt.Fprint(w, `{"code":42901,"message":"invited too soon","details":{"retry_after_seconds":600}}`)
+			default:
+				invited[body["email"]] = true
+				chk.Check(body["role"], Equals, "guest")
+				chk.Check(body["message"], Equals, "Vacation photos")
+				fmt.Fprintf(w, `{"code":0,"result":{"invitation_id":"si-1","email":%q,"role":"guest","message":"Vacation photos",
+					"created_at":"2017-02-23T08:00:00Z","expires_at":"2017-03-02T08:00:00Z"}}`, body["email"])
+			}
+		case r.Method == "GET" && r.URL.Path == "/v1.1/devices/d1/share-invitations":
+			fmt.Fprint(w, `{"code":0,"result":{"invitations":[`)
+			if invited["bob@example.com"] {
+				fmt.Fprint(w, `{"invitation_id":"si-1","email":"bob@example.com","role":"guest","expires_at":"2017-03-02T08:00:00Z"}`)
+			}
+			fmt.Fprint(w, `]}}`)
+		case r.Method == "DELETE" && r.URL.Path == "/v1.1/devices/d1/share-invitations/si-1":
+			delete(invited, "bob@example.com")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			chk.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_ShareInvite(chk *C) {
+	svc, ts := newFakeService(fakeShareInvitationsAPI(chk))
+	defer ts.Close()
+
+	res, err := svc.Device.ShareInvite("d1", "bob@example.com", DeviceGuest, "Vacation photos").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.InvitationID, Equals, "si-1")
+	chk.Check(res.Result.ExpiresAt.Equal(time.Date(2017, 3, 2, 8, 0, 0, 0, time.UTC)), Equals, true)
+
+	_, err = svc.Device.ShareInvite("d1", "bob@example.com", DeviceGuest, "Vacation photos").Do()
+	chk.Check(errors.Is(err, ErrResendCooldown), Equals, true)
+	d, ok := ResendCooldown(err)
+	chk.Check(ok, Equals, true)
+	chk.Check(d, Equals, 10*time.Minute)
+
+	_, err = svc.Device.ShareInvite("d1", "alice@example.com", DeviceGuest, "").Do()
+	chk.Check(errors.Is(err, ErrAlreadyHasAccess), Equals, true)
+
+	list, err := svc.Device.ShareInvitations("d1").Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(list.Result.Invitations, HasLen, 1)
+	chk.Check(list.Result.Invitations[0].Email, Equals, "bob@example.com")
+	chk.Check(list.Result.Invitations[0].Role, Equals, DeviceGuest)
+
+	chk.Assert(svc.Device.CancelShareInvite("d1", "si-1").Do(), IsNil)
+
+	list, err = svc.Device.ShareInvitations("d1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(list.Result.Invitations, NotNil)
+	chk.Check(list.Result.Invitations, HasLen, 0)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_ShareInvite_Invalid(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Error("unexpected request")
+	})
+	defer ts.Close()
+
+	_, err := svc.Device.ShareInvite("d1", "bob", DeviceGuest, "").Do()
+	chk.Check(err, ErrorMatches, `account: invalid email address "bob"`)
+	_, err = svc.Device.ShareInvite("d1", "bob@example.com", DeviceOwner, "").Do()
+	chk.Check(err, ErrorMatches, `account: cannot grant role "owner"`)
+}
@@ -0,0 +1,192 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// ErrDeviceCodeExpired is matched by errors.Is when the user did not
+// approve a device authorization in time. It must be started over.
+var ErrDeviceCodeExpired = errors.New("account: device code expired")
+
+// ErrAccessDenied is matched by errors.Is when the user declined a device
+// authorization.
+var ErrAccessDenied = errors.New("account: access denied")
+
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// defaultPollInterval is the polling interval used when the server does
+// not set one.
+const defaultPollInterval = 5 * time.Second
+
+// slowDownStep is added to the polling interval each time the server
+// asks to slow down.
+var slowDownStep = 5 * time.Second
+
+// A DeviceAuthorization is a pending authorization of a device that
+// cannot open a browser, such as a NAS. The user approves it by entering
+// UserCode at VerificationURI from another device.
+type DeviceAuthorization struct {
+	DeviceCode      Secret `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+
+	// VerificationURIComplete is VerificationURI with the user code
+	// included, suitable for a QR code. It may be empty.
+	VerificationURIComplete string `json:"verification_uri_complete"`
+
+	// ExpiresIn is how long the codes remain valid.
+	ExpiresIn time.Duration `json:"-"`
+
+	// Interval is the minimum delay between two polls for the token.
+	Interval time.Duration `json:"-"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DeviceAuthorization) UnmarshalJSON(b []byte) error {
+	type plain DeviceAuthorization
+	var raw struct {
+		plain
+		ExpiresIn int `json:"expires_in"`
+		Interval  int `json:"interval"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*d = DeviceAuthorization(raw.plain)
+	d.ExpiresIn = time.Duration(raw.ExpiresIn) * time.Second
+	d.Interval = time.Duration(raw.Interval) * time.Second
+	if d.Interval <= 0 {
+		d.Interval = defaultPollInterval
+	}
+	return nil
+}
+
+type TokenStartDeviceAuthorizationCall struct {
+	s        *Service
+	clientID string
+	scopes   []string
+}
+
+// StartDeviceAuthorization starts the OAuth device authorization grant
+// for the client. Display the returned user code and verification URI to
+// the user, then call PollForToken with the device code.
+func (r *TokenService) StartDeviceAuthorization(clientID string, scopes []string) *TokenStartDeviceAuthorizationCall {
+	c := &TokenStartDeviceAuthorizationCall{s: r.s, clientID: clientID, scopes: scopes}
+	return c
+}
+
+func (c *TokenStartDeviceAuthorizationCall) Do() (*DeviceAuthorization, error) {
+	if c.clientID == "" {
+		return nil, errors.New("account: empty client ID")
+	}
+	path := versioned("oauth/device/code")
+	form := url.Values{"client_id": {c.clientID}}
+	if len(c.scopes) > 0 {
+		form.Set("scope", strings.Join(c.scopes, " "))
+	}
+	ret := &DeviceAuthorization{}
+	_, err := c.s.postForm(context.Background(), path, form, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type TokenPollForTokenCall struct {
+	s          *Service
+	ctx        context.Context
+	deviceCode string
+	clientID   string
+	interval   time.Duration
+}
+
+// PollForToken waits for the user to approve a device authorization and
+// returns the issued token, ready to build the client given to New.
+//
+// ClientID is required: Do fails without it. Set Interval to the one of
+// the DeviceAuthorization, so that polling follows the server; it
+// defaults to 5 seconds, and grows when the server asks to slow down.
+//
+// The returned error matches ErrAccessDenied if the user declined, and
+// ErrDeviceCodeExpired if the user did not answer in time. Cancel ctx to
+// give up earlier.
+func (r *TokenService) PollForToken(ctx context.Context, deviceCode string) *TokenPollForTokenCall {
+	c := &TokenPollForTokenCall{s: r.s, ctx: ctx, deviceCode: deviceCode, interval: defaultPollInterval}
+	return c
+}
+
+// ClientID sets the client the authorization was started for.
+func (c *TokenPollForTokenCall) ClientID(clientID string) *TokenPollForTokenCall {
+	c.clientID = clientID
+	return c
+}
+
+// Interval sets the delay between two polls, the Interval of the
+// DeviceAuthorization.
+func (c *TokenPollForTokenCall) Interval(d time.Duration) *TokenPollForTokenCall {
+	if d > 0 {
+		c.interval = d
+	}
+	return c
+}
+
+type deviceTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+func (c *TokenPollForTokenCall) Do() (*oauth2.Token, error) {
+	if c.clientID == "" {
+		return nil, errors.New("account: PollForToken needs ClientID")
+	}
+	if c.deviceCode == "" {
+		return nil, errors.New("account: empty device code")
+	}
+	path := versioned("oauth/token")
+	form := url.Values{
+		"grant_type":  {deviceCodeGrantType},
+		"device_code": {c.deviceCode},
+		"client_id":   {c.clientID},
+	}
+	interval := c.interval
+	t := time.NewTimer(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return nil, c.ctx.Err()
+		case <-t.C:
+		}
+
+		ret := &deviceTokenResponse{}
+		_, err := c.s.postForm(c.ctx, path, form, ret)
+		var e *ErrorResponse
+		switch {
+		case err == nil:
+			tok := &oauth2.Token{
+				AccessToken:  ret.AccessToken,
+				TokenType:    ret.TokenType,
+				RefreshToken: ret.RefreshToken,
+			}
+			if ret.ExpiresIn > 0 {
+				tok.Expiry = now().Add(time.Duration(ret.ExpiresIn) * time.Second)
+			}
+			return tok, nil
+		case errors.As(err, &e) && e.OAuthError == "authorization_pending":
+		case errors.As(err, &e) && e.OAuthError == "slow_down":
+			interval += slowDownStep
+		default:
+			return nil, err
+		}
+		t.Reset(interval)
+	}
+}
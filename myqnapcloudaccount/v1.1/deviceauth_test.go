@@ -0,0 +1,107 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Token_StartDeviceAuthorization(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/oauth/device/code")
+		chk.Assert(r.ParseForm(), IsNil)
+		chk.Check(r.PostForm.Get("client_id"), Equals, "nas-app")
+		chk.Check(r.PostForm.Get("scope"), Equals, "profile:read devices:read")
+		fmt.Fprint(w, `{"device_code":"dc_0123456789","user_code":"WDJB-MJHT",
+			"verification_uri":"https://account.myqnapcloud.com/device",
+			"verification_uri_complete":"https://account.myqnapcloud.com/device?user_code=WDJB-MJHT",
+			"expires_in":900,"interval":5}`)
+	})
+	defer ts.Close()
+
+	auth, err := svc.Token.StartDeviceAuthorization("nas-app", []string{"profile:read", "devices:read"}).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(string(auth.DeviceCode), Equals, "dc_0123456789")
+	chk.Check(auth.UserCode, Equals, "WDJB-MJHT")
+	chk.Check(auth.VerificationURI, Equals, "https://account.myqnapcloud.com/device")
+	chk.Check(auth.VerificationURIComplete, Equals, "https://account.myqnapcloud.com/device?user_code=WDJB-MJHT")
+	chk.Check(auth.ExpiresIn, Equals, 15*time.Minute)
+	chk.Check(auth.Interval, Equals, 5*time.Second)
+	chk.Check(fmt.Sprintf("%v", auth), Not(Matches), "(?s).*0123456789.*")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Token_PollForToken(chk *C) {
+	defer func(d time.Duration) { slowDownStep = d }(slowDownStep)
+	slowDownStep = 40 * time.Millisecond
+
+	script := []string{"authorization_pending", "slow_down", "authorization_pending", ""}
+	var polls []time.Time
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/oauth/token")
+		chk.Assert(r.ParseForm(), IsNil)
+		chk.Check(r.PostForm.Get("grant_type"), Equals, "urn:ietf:params:oauth:grant-type:device_code")
+		chk.Check(r.PostForm.Get("device_code"), Equals, "dc_0123456789")
+		chk.Check(r.PostForm.Get("client_id"), Equals, "nas-app")
+		polls = append(polls, time.Now())
+		step := script[len(polls)-1]
+		w.Header().Set("Content-Type", "application/json")
+		if step != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error":%q}`, step)
+			return
+		}
+		fmt.Fprint(w, `{"access_token":"at_1","token_type":"Bearer","refresh_token":"rt_1","expires_in":3600}`)
+	})
+	defer ts.Close()
+
+	tok, err := svc.Token.PollForToken(context.Background(), "dc_0123456789").
+		ClientID("nas-app").Interval(10 * time.Millisecond).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(tok.AccessToken, Equals, "at_1")
+	chk.Check(tok.TokenType, Equals, "Bearer")
+	chk.Check(tok.RefreshToken, Equals, "rt_1")
+	chk.Check(tok.Valid(), Equals, true)
+	chk.Check(tok.Expiry.After(time.Now().Add(59*time.Minute)), Equals, true)
+
+	// Polls after slow_down are spaced by the increased interval.
+	chk.Assert(polls, HasLen, 4)
+	chk.Check(polls[2].Sub(polls[1]) >= 50*time.Millisecond, Equals, true)
+	chk.Check(polls[3].Sub(polls[2]) >= 50*time.Millisecond, Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Token_PollForToken_Errors(chk *C) {
+	for _, t := range []struct {
+		code string
+		want error
+	}{
+		{"expired_token", ErrDeviceCodeExpired},
+		{"access_denied", ErrAccessDenied},
+	} {
+		svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error":%q}`, t.code)
+		})
+		_, err := svc.Token.PollForToken(context.Background(), "dc_1").
+			ClientID("nas-app").Interval(time.Millisecond).Do()
+		chk.Check(errors.Is(err, t.want), Equals, true, Commentf(t.code))
+		ts.Close()
+	}
+
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":"authorization_pending"}`)
+	})
+	defer ts.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	_, err := svc.Token.PollForToken(ctx, "dc_1").ClientID("nas-app").Interval(time.Millisecond).Do()
+	chk.Check(errors.Is(err, context.DeadlineExceeded), Equals, true)
+
+	_, err = svc.Token.PollForToken(ctx, "dc_1").Do()
+	chk.Check(err, ErrorMatches, "account: PollForToken needs ClientID")
+}
@@ -0,0 +1,75 @@
+package account
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+// ErrCloudLinkExpired is matched by errors.Is when a CloudLink session
+// expired; get a new one with RenewCloudLink.
+var ErrCloudLinkExpired = errors.New("account: CloudLink session expired")
+
+const codeCloudLinkExpired = 41004
+
+// A CloudLinkSession is the relay connection to a device through
+// CloudLink.
+type CloudLinkSession struct {
+	// Region is the relay region, such as "ap-northeast".
+	Region   string `json:"region"`
+	Endpoint string `json:"endpoint"`
+
+	// Token authenticates the session on the relay.
+	Token     Secret    `json:"session_token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type DeviceCloudLinkResponse struct {
+	Message string           `json:"message"`
+	Code    int              `json:"code"`
+	Result  CloudLinkSession `json:"result"`
+}
+
+type DeviceCloudLinkCall struct {
+	s  *Service
+	id string
+}
+
+// CloudLink retrieves the CloudLink relay session of a device. When the
+// session expired, the returned error matches ErrCloudLinkExpired.
+func (r *DeviceService) CloudLink(deviceID string) *DeviceCloudLinkCall {
+	c := &DeviceCloudLinkCall{s: r.s, id: deviceID}
+	return c
+}
+
+func (c *DeviceCloudLinkCall) Do() (*DeviceCloudLinkResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/cloudlink")
+	ret := &DeviceCloudLinkResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type DeviceRenewCloudLinkCall struct {
+	s  *Service
+	id string
+}
+
+// RenewCloudLink opens a new CloudLink relay session to a device,
+// replacing the current one.
+func (r *DeviceService) RenewCloudLink(deviceID string) *DeviceRenewCloudLinkCall {
+	c := &DeviceRenewCloudLinkCall{s: r.s, id: deviceID}
+	return c
+}
+
+func (c *DeviceRenewCloudLinkCall) Do() (*DeviceCloudLinkResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/cloudlink/renew")
+	ret := &DeviceCloudLinkResponse{}
+	_, err := c.s.post(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,60 @@
+package account
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_CloudLink(chk *C) {
+	renewed := false
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v1.1/devices/d1/cloudlink":
+			if !renewed {
+				w.WriteHeader(http.StatusGone)
+				fmt.Fprint(w, `{"code":41004,"message":"session expired"}`)
+				return
+			}
+			fmt.Fprint(w, `{"code":0,"result":{"region":"ap-northeast","endpoint":"relay-ap1.myqnapcloud.com:443",
+				"session_token":"cl-tok-0123456789","expires_at":"2017-02-23T10:00:00Z"}}`)
+		case "POST /v1.1/devices/d1/cloudlink/renew":
+			renewed = true
+			fmt.Fprint(w, `{"code":0,"result":{"region":"ap-northeast","endpoint":"relay-ap1.myqnapcloud.com:443",
+				"session_token":"cl-tok-0123456789","expires_at":"2017-02-23T10:00:00Z"}}`)
+		default:
+			chk.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer ts.Close()
+
+	logs := &bytes.Buffer{}
+	log.SetOutput(logs)
+	defer log.SetOutput(os.Stderr)
+	svc.Debug = true
+
+	_, err := svc.Device.CloudLink("d1").Do()
+	chk.Check(errors.Is(err, ErrCloudLinkExpired), Equals, true)
+
+	res, err := svc.Device.RenewCloudLink("d1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(string(res.Result.Token), Equals, "cl-tok-0123456789")
+
+	res, err = svc.Device.CloudLink("d1").Do()
+	chk.Assert(err, IsNil)
+	l := res.Result
+	chk.Check(l.Region, Equals, "ap-northeast")
+	chk.Check(l.Endpoint, Equals, "relay-ap1.myqnapcloud.com:443")
+	chk.Check(string(l.Token), Equals, "cl-tok-0123456789")
+	chk.Check(l.ExpiresAt.Equal(time.Date(2017, 2, 23, 10, 0, 0, 0, time.UTC)), Equals, true)
+
+	chk.Check(fmt.Sprintf("%v %+v %#v", res, res.Result, res.Result), Not(Matches), "(?s).*cl-tok.*")
+	chk.Check(strings.Contains(logs.String(), "cl-tok"), Equals, false)
+}
@@ -0,0 +1,93 @@
+package account
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// ProbeState is the outcome of probing a connection path to a device.
+type ProbeState string
+
+const (
+	ProbePending     ProbeState = "pending"
+	ProbeReachable   ProbeState = "reachable"
+	ProbeUnreachable ProbeState = "unreachable"
+)
+
+// A PathProbe is the result of probing one connection path to a device.
+type PathProbe struct {
+	Path  CandidateKind `json:"path"`
+	State ProbeState    `json:"state"`
+
+	// LatencyMS is the round trip time in milliseconds, for
+	// ProbeReachable.
+	LatencyMS int `json:"latency_ms"`
+}
+
+type DeviceConnectivityResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		TestID string       `json:"test_id"`
+		Probes []*PathProbe `json:"probes"`
+
+		// Done is set once no probe is ProbePending anymore.
+		Done bool `json:"done"`
+	} `json:"result"`
+}
+
+type DeviceTestConnectivityCall struct {
+	s        *Service
+	ctx      context.Context
+	id       string
+	interval time.Duration
+}
+
+// TestConnectivity probes the connection paths to a device, and waits for
+// the results.
+//
+// If ctx is done before every path was probed, Do returns the results so
+// far, with pending probes, along with the context error.
+func (r *DeviceService) TestConnectivity(ctx context.Context, deviceID string) *DeviceTestConnectivityCall {
+	c := &DeviceTestConnectivityCall{s: r.s, ctx: ctx, id: deviceID}
+	return c
+}
+
+// PollInterval sets how often the test results are polled.
+func (c *DeviceTestConnectivityCall) PollInterval(d time.Duration) *DeviceTestConnectivityCall {
+	c.interval = d
+	return c
+}
+
+func (c *DeviceTestConnectivityCall) Do() (*DeviceConnectivityResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/connectivity-tests")
+	req, err := c.s.doRequest("POST", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := &DeviceConnectivityResponse{}
+	if _, err := c.s.do(req.WithContext(c.ctx), ret); err != nil {
+		return nil, err
+	}
+	if ret.Result.Done {
+		return ret, nil
+	}
+
+	path += "/" + url.PathEscape(ret.Result.TestID)
+	err = WaitFor(c.ctx, func() (bool, error) {
+		res := &DeviceConnectivityResponse{}
+		if _, err := c.s.getContext(c.ctx, path, res); err != nil {
+			return false, err
+		}
+		ret = res
+		return res.Result.Done, nil
+	}, WaitInterval(c.interval))
+	if err != nil && c.ctx.Err() != nil {
+		return ret, c.ctx.Err()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
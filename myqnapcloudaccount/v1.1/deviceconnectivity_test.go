@@ -0,0 +1,67 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_TestConnectivity(chk *C) {
+	polls := 0
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v1.1/devices/d1/connectivity-tests":
+			fmt.Fprint(w, `{"code":0,"result":{"test_id":"t1","probes":[
+				{"path":"lan","state":"pending"},{"path":"wan","state":"pending"},{"path":"relay","state":"pending"}]}}`)
+		case "GET /v1.1/devices/d1/connectivity-tests/t1":
+			polls++
+			if polls < 3 {
+				fmt.Fprint(w, `{"code":0,"result":{"test_id":"t1","probes":[
+					{"path":"lan","state":"reachable","latency_ms":2},{"path":"wan","state":"pending"},{"path":"relay","state":"pending"}]}}`)
+				return
+			}
+			fmt.Fprint(w, `{"code":0,"result":{"test_id":"t1","done":true,"probes":[
+				{"path":"lan","state":"reachable","latency_ms":2},{"path":"wan","state":"unreachable"},
+				{"path":"relay","state":"reachable","latency_ms":180}]}}`)
+		default:
+			chk.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.TestConnectivity(context.Background(), "d1").PollInterval(time.Millisecond).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(polls, Equals, 3)
+	chk.Check(res.Result.Done, Equals, true)
+	p := res.Result.Probes
+	chk.Assert(p, HasLen, 3)
+	chk.Check(*p[0], Equals, PathProbe{Path: CandidateLAN, State: ProbeReachable, LatencyMS: 2})
+	chk.Check(*p[1], Equals, PathProbe{Path: CandidateWAN, State: ProbeUnreachable})
+	chk.Check(*p[2], Equals, PathProbe{Path: CandidateRelay, State: ProbeReachable, LatencyMS: 180})
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_TestConnectivity_Timeout(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			fmt.Fprint(w, `{"code":0,"result":{"test_id":"t1","probes":[{"path":"relay","state":"pending"}]}}`)
+		case "GET":
+			fmt.Fprint(w, `{"code":0,"result":{"test_id":"t1","probes":[
+				{"path":"lan","state":"reachable","latency_ms":3},{"path":"relay","state":"pending"}]}}`)
+		}
+	})
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	res, err := svc.Device.TestConnectivity(ctx, "d1").PollInterval(5 * time.Millisecond).Do()
+	chk.Check(err, Equals, context.DeadlineExceeded)
+	chk.Assert(res, NotNil)
+	chk.Check(res.Result.Done, Equals, false)
+	chk.Assert(res.Result.Probes, HasLen, 2)
+	chk.Check(res.Result.Probes[0].State, Equals, ProbeReachable)
+	chk.Check(res.Result.Probes[1].State, Equals, ProbePending)
+}
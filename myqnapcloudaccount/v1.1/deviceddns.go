@@ -0,0 +1,68 @@
+package account
+
+import (
+	"net/url"
+	"time"
+)
+
+// A DDNSRecord is the myQNAPcloud DDNS record of a device.
+type DDNSRecord struct {
+	Hostname string `json:"hostname"`
+
+	// A and AAAA are the IPv4 and IPv6 addresses the hostname resolves
+	// to, empty when the record has none.
+	A    string `json:"a"`
+	AAAA string `json:"aaaa"`
+
+	UpdatedAt  time.Time `json:"updated_at"`
+	TTLSeconds int       `json:"ttl_seconds"`
+}
+
+type DeviceDDNSResponse struct {
+	Message string     `json:"message"`
+	Code    int        `json:"code"`
+	Result  DDNSRecord `json:"result"`
+}
+
+type DeviceDDNSCall struct {
+	s  *Service
+	id string
+}
+
+// DDNS retrieves the myQNAPcloud DDNS record of a device.
+func (r *DeviceService) DDNS(deviceID string) *DeviceDDNSCall {
+	c := &DeviceDDNSCall{s: r.s, id: deviceID}
+	return c
+}
+
+func (c *DeviceDDNSCall) Do() (*DeviceDDNSResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/ddns")
+	ret := &DeviceDDNSResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type DeviceRefreshDDNSCall struct {
+	s  *Service
+	id string
+}
+
+// RefreshDDNS updates the myQNAPcloud DDNS record of a device with its
+// current addresses, and returns the updated record.
+func (r *DeviceService) RefreshDDNS(deviceID string) *DeviceRefreshDDNSCall {
+	c := &DeviceRefreshDDNSCall{s: r.s, id: deviceID}
+	return c
+}
+
+func (c *DeviceRefreshDDNSCall) Do() (*DeviceDDNSResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/ddns/refresh")
+	ret := &DeviceDDNSResponse{}
+	_, err := c.s.post(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
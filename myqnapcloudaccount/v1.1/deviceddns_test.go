@@ -0,0 +1,58 @@
+package account
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_DDNS(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		switch r.URL.Path {
+		case "/v1.1/devices/dual/ddns":
+			fmt.Fprint(w, `{"code":0,"result":{"hostname":"dual.myqnapcloud.com","a":"203.0.113.7",
+				"aaaa":"2001:db8::7","updated_at":"2017-02-23T08:00:00Z","ttl_seconds":300}}`)
+		case "/v1.1/devices/v4/ddns":
+			fmt.Fprint(w, `{"code":0,"result":{"hostname":"v4.myqnapcloud.com","a":"203.0.113.8","ttl_seconds":300}}`)
+		case "/v1.1/devices/v6/ddns":
+			fmt.Fprint(w, `{"code":0,"result":{"hostname":"v6.myqnapcloud.com","a":"","aaaa":"2001:db8::9","ttl_seconds":60}}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.DDNS("dual").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Hostname, Equals, "dual.myqnapcloud.com")
+	chk.Check(res.Result.A, Equals, "203.0.113.7")
+	chk.Check(res.Result.AAAA, Equals, "2001:db8::7")
+	chk.Check(res.Result.UpdatedAt.Equal(time.Date(2017, 2, 23, 8, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(res.Result.TTLSeconds, Equals, 300)
+
+	res, err = svc.Device.DDNS("v4").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.A, Equals, "203.0.113.8")
+	chk.Check(res.Result.AAAA, Equals, "")
+
+	res, err = svc.Device.DDNS("v6").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.A, Equals, "")
+	chk.Check(res.Result.AAAA, Equals, "2001:db8::9")
+	chk.Check(res.Result.TTLSeconds, Equals, 60)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_RefreshDDNS(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/devices/d1/ddns/refresh")
+		fmt.Fprint(w, `{"code":0,"result":{"hostname":"home.myqnapcloud.com","a":"203.0.113.9","updated_at":"2017-02-23T09:00:00Z"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.RefreshDDNS("d1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.A, Equals, "203.0.113.9")
+	chk.Check(res.Result.UpdatedAt.Equal(time.Date(2017, 2, 23, 9, 0, 0, 0, time.UTC)), Equals, true)
+}
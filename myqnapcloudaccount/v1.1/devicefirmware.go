@@ -0,0 +1,126 @@
+package account
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A FirmwareVersion is a parsed QTS firmware version, such as
+// "4.3.4.0695"; the zero FirmwareVersion stands for an unknown version.
+type FirmwareVersion struct {
+	Major, Minor, Patch int
+
+	// Build is the fourth component, zero when absent.
+	Build int
+}
+
+// ParseFirmwareVersion parses a version made of three or four numbers
+// separated by dots.
+func ParseFirmwareVersion(s string) (FirmwareVersion, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 && len(parts) != 4 {
+		return FirmwareVersion{}, fmt.Errorf("account: invalid firmware version %q", s)
+	}
+	var n [4]int
+	for i, p := range parts {
+		v, err := strconv.Atoi(p)
+		if err != nil || v < 0 || p[0] == '+' {
+			return FirmwareVersion{}, fmt.Errorf("account: invalid firmware version %q", s)
+		}
+		n[i] = v
+	}
+	return FirmwareVersion{n[0], n[1], n[2], n[3]}, nil
+}
+
+// IsZero reports whether v is the zero FirmwareVersion.
+func (v FirmwareVersion) IsZero() bool {
+	return v == FirmwareVersion{}
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is older than, the
+// same as, or newer than w.
+func (v FirmwareVersion) Compare(w FirmwareVersion) int {
+	a := [4]int{v.Major, v.Minor, v.Patch, v.Build}
+	b := [4]int{w.Major, w.Minor, w.Patch, w.Build}
+	for i := range a {
+		switch {
+		case a[i] < b[i]:
+			return -1
+		case a[i] > b[i]:
+			return +1
+		}
+	}
+	return 0
+}
+
+// String returns v in its dotted form, or "" for the zero
+// FirmwareVersion. The build is written with four digits, as QTS does.
+func (v FirmwareVersion) String() string {
+	if v.IsZero() {
+		return ""
+	}
+	if v.Build == 0 {
+		return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	}
+	return fmt.Sprintf("%d.%d.%d.%04d", v.Major, v.Minor, v.Patch, v.Build)
+}
+
+type DeviceFirmwareResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Model string `json:"model"`
+
+		// Current and Latest are the versions as reported, which
+		// ParseFirmwareVersion parses. Latest is the newest firmware
+		// available for the model, empty if unknown.
+		Current         string `json:"current_version"`
+		Latest          string `json:"latest_version"`
+		ReleaseNotesURL string `json:"release_notes_url"`
+		UpdateAvailable bool   `json:"update_available"`
+
+		// StaleSince is set when the device has not reported its firmware
+		// recently, to when it last did; Current may be outdated then.
+		StaleSince time.Time `json:"stale_since"`
+	} `json:"result"`
+
+	// UpdateMismatch is set when UpdateAvailable, as computed by the API,
+	// disagrees with comparing Latest with Current. It is never set when
+	// either version does not parse.
+	UpdateMismatch bool `json:"-"`
+}
+
+type DeviceFirmwareCall struct {
+	s  *Service
+	id string
+}
+
+// Firmware retrieves the firmware of a device, and whether an update is
+// available for it.
+func (r *DeviceService) Firmware(deviceID string) *DeviceFirmwareCall {
+	c := &DeviceFirmwareCall{s: r.s, id: deviceID}
+	return c
+}
+
+func (c *DeviceFirmwareCall) Do() (*DeviceFirmwareResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/firmware")
+	ret := &DeviceFirmwareResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	r := &ret.Result
+	current, err := ParseFirmwareVersion(r.Current)
+	if err != nil {
+		return ret, nil
+	}
+	latest, err := ParseFirmwareVersion(r.Latest)
+	if err != nil {
+		return ret, nil
+	}
+	ret.UpdateMismatch = r.UpdateAvailable != (latest.Compare(current) > 0)
+	return ret, nil
+}
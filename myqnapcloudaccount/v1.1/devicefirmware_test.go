@@ -0,0 +1,80 @@
+package account
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_FirmwareVersion(chk *C) {
+	for _, str := range []string{"4.2.3", "4.3.4.0695", "5.0.1.2034"} {
+		v, err := ParseFirmwareVersion(str)
+		chk.Assert(err, IsNil)
+		chk.Check(v.String(), Equals, str)
+	}
+
+	v, err := ParseFirmwareVersion("4.3.4.0695")
+	chk.Assert(err, IsNil)
+	chk.Check(v, Equals, FirmwareVersion{4, 3, 4, 695})
+	chk.Check(v.Compare(FirmwareVersion{4, 3, 4, 695}), Equals, 0)
+	chk.Check(v.Compare(FirmwareVersion{4, 3, 4, 0}), Equals, +1)
+	chk.Check(v.Compare(FirmwareVersion{4, 3, 10, 0}), Equals, -1)
+	chk.Check(v.Compare(FirmwareVersion{5, 0, 0, 0}), Equals, -1)
+
+	for _, str := range []string{"4.3", "4.3.4.0695.1", "4.x.4", "4..4", "v4.3.4", "4.3.-1"} {
+		_, err := ParseFirmwareVersion(str)
+		chk.Check(err, NotNil, Commentf(str))
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_Firmware(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		switch r.URL.Path {
+		case "/v1.1/devices/current/firmware":
+			fmt.Fprint(w, `{"code":0,"result":{"model":"TS-453A","current_version":"4.3.4.0695",
+				"latest_version":"4.3.4.0695","update_available":false}}`)
+		case "/v1.1/devices/outdated/firmware":
+			fmt.Fprint(w, `{"code":0,"result":{"model":"TS-251","current_version":"4.2.3.0312",
+				"latest_version":"4.3.4.0695","update_available":true,
+				"release_notes_url":"https://www.qnap.com/en/release-notes/qts/4.3.4.0695"}}`)
+		case "/v1.1/devices/beta/firmware":
+			fmt.Fprint(w, `{"code":0,"result":{"model":"TS-453A","current_version":"4.3.5 beta",
+				"latest_version":"4.3.4.0","update_available":false}}`)
+		case "/v1.1/devices/stale/firmware":
+			fmt.Fprint(w, `{"code":0,"result":{"model":"TS-451","current_version":"4.2.3",
+				"latest_version":"4.3.4.0695","update_available":false,"stale_since":"2017-01-10T08:00:00Z"}}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.Firmware("current").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Model, Equals, "TS-453A")
+	chk.Check(res.Result.Current, Equals, "4.3.4.0695")
+	chk.Check(res.Result.UpdateAvailable, Equals, false)
+	chk.Check(res.UpdateMismatch, Equals, false)
+	chk.Check(res.Result.StaleSince.IsZero(), Equals, true)
+
+	res, err = svc.Device.Firmware("outdated").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.UpdateAvailable, Equals, true)
+	chk.Check(res.UpdateMismatch, Equals, false)
+	chk.Check(res.Result.Current, Equals, "4.2.3.0312")
+	chk.Check(res.Result.ReleaseNotesURL, Equals, "https://www.qnap.com/en/release-notes/qts/4.3.4.0695")
+
+	res, err = svc.Device.Firmware("stale").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.StaleSince.Equal(time.Date(2017, 1, 10, 8, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(res.Result.UpdateAvailable, Equals, false)
+	chk.Check(res.UpdateMismatch, Equals, true)
+
+	// Versions that do not parse are kept as reported, and never flagged.
+	res, err = svc.Device.Firmware("beta").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Current, Equals, "4.3.5 beta")
+	chk.Check(res.Result.Latest, Equals, "4.3.4.0")
+	chk.Check(res.UpdateMismatch, Equals, false)
+}
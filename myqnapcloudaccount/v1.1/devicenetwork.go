@@ -0,0 +1,81 @@
+package account
+
+import (
+	"net"
+	"net/url"
+	"time"
+)
+
+// A NetworkInterface is a network interface of a device. Addresses are
+// kept as reported; use HardwareAddr and IPs to parse them.
+type NetworkInterface struct {
+	Name string `json:"name"` // such as "eth0"
+	MAC  string `json:"mac"`
+
+	IPv4 []string `json:"ipv4"`
+	IPv6 []string `json:"ipv6"`
+}
+
+// HardwareAddr parses the MAC address of the interface.
+func (i *NetworkInterface) HardwareAddr() (net.HardwareAddr, error) {
+	return net.ParseMAC(i.MAC)
+}
+
+// IPs parses the IPv4 and IPv6 addresses of the interface, skipping the
+// invalid ones. Addresses may carry a prefix length, as in "10.0.0.2/24".
+func (i *NetworkInterface) IPs() []net.IP {
+	var ips []net.IP
+	for _, list := range [][]string{i.IPv4, i.IPv6} {
+		for _, s := range list {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				ip, _, _ = net.ParseCIDR(s)
+			}
+			if ip != nil {
+				ips = append(ips, ip)
+			}
+		}
+	}
+	return ips
+}
+
+type DeviceNetworkResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Interfaces is nil when the device did not report them.
+		Interfaces []*NetworkInterface `json:"interfaces"`
+
+		// Gateway and IPv6Gateway are the default gateways, empty if
+		// unknown.
+		Gateway     string `json:"gateway"`
+		IPv6Gateway string `json:"ipv6_gateway"`
+		WANIP       string `json:"wan_ip"`
+
+		// DoubleNAT is set when the device found itself behind two
+		// levels of NAT, which prevents UPnP port mapping.
+		DoubleNAT  bool      `json:"double_nat"`
+		ReportedAt time.Time `json:"reported_at"`
+	} `json:"result"`
+}
+
+type DeviceNetworkCall struct {
+	s  *Service
+	id string
+}
+
+// Network retrieves the network configuration last reported by a device.
+func (r *DeviceService) Network(deviceID string) *DeviceNetworkCall {
+	c := &DeviceNetworkCall{s: r.s, id: deviceID}
+	return c
+}
+
+func (c *DeviceNetworkCall) Do() (*DeviceNetworkResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/network")
+	ret := &DeviceNetworkResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,64 @@
+package account
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_Network(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		switch r.URL.Path {
+		case "/v1.1/devices/multi/network":
+			fmt.Fprint(w, `{"code":0,"result":{"interfaces":[
+				{"name":"eth0","mac":"24:5e:be:01:02:03","ipv4":["192.168.1.20/24"],"ipv6":["fe80::265e:beff:fe01:203"]},
+				{"name":"eth1","mac":"24:5e:be:01:02:04","ipv4":["10.0.0.2"]}
+			],"gateway":"192.168.1.1","wan_ip":"203.0.113.7","double_nat":true,"reported_at":"2017-02-23T08:00:00Z"}}`)
+		case "/v1.1/devices/v6/network":
+			fmt.Fprint(w, `{"code":0,"result":{"interfaces":[
+				{"name":"eth0","mac":"24:5e:be:0a:0b:0c","ipv6":["2001:db8::20/64","bogus"]}
+			],"ipv6_gateway":"2001:db8::1"}}`)
+		case "/v1.1/devices/partial/network":
+			fmt.Fprint(w, `{"code":0,"result":{"interfaces":[{"name":"eth0"}]}}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.Network("multi").Do()
+	chk.Assert(err, IsNil)
+	n := res.Result
+	chk.Assert(n.Interfaces, HasLen, 2)
+	chk.Check(n.Gateway, Equals, "192.168.1.1")
+	chk.Check(n.WANIP, Equals, "203.0.113.7")
+	chk.Check(n.DoubleNAT, Equals, true)
+	mac, err := n.Interfaces[0].HardwareAddr()
+	chk.Assert(err, IsNil)
+	chk.Check(mac, DeepEquals, net.HardwareAddr{0x24, 0x5e, 0xbe, 0x01, 0x02, 0x03})
+	ips := n.Interfaces[0].IPs()
+	chk.Assert(ips, HasLen, 2)
+	chk.Check(ips[0].Equal(net.ParseIP("192.168.1.20")), Equals, true)
+	chk.Check(ips[1].Equal(net.ParseIP("fe80::265e:beff:fe01:203")), Equals, true)
+	chk.Check(n.Interfaces[1].IPv6, IsNil)
+
+	res, err = svc.Device.Network("v6").Do()
+	chk.Assert(err, IsNil)
+	n = res.Result
+	chk.Check(n.Gateway, Equals, "")
+	chk.Check(n.IPv6Gateway, Equals, "2001:db8::1")
+	chk.Assert(n.Interfaces, HasLen, 1)
+	chk.Check(n.Interfaces[0].IPv4, IsNil)
+	ips = n.Interfaces[0].IPs()
+	chk.Assert(ips, HasLen, 1)
+	chk.Check(ips[0].Equal(net.ParseIP("2001:db8::20")), Equals, true)
+
+	res, err = svc.Device.Network("partial").Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(res.Result.Interfaces, HasLen, 1)
+	_, err = res.Result.Interfaces[0].HardwareAddr()
+	chk.Check(err, NotNil)
+	chk.Check(res.Result.Interfaces[0].IPs(), IsNil)
+	chk.Check(res.Result.ReportedAt.IsZero(), Equals, true)
+}
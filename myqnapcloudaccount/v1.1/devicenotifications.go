@@ -0,0 +1,77 @@
+package account
+
+import "net/url"
+
+// A DeviceNotifyPref is whether a notification category is delivered for
+// a device.
+type DeviceNotifyPref struct {
+	Enabled bool `json:"enabled"`
+
+	// Inherited is true if Enabled comes from the notification settings
+	// of the account rather than from a setting of the device.
+	Inherited bool `json:"inherited"`
+}
+
+// DeviceNotifyPrefs turns notification categories on or off for a device.
+// Categories missing from the map are left as they are.
+type DeviceNotifyPrefs map[NotificationCategory]bool
+
+type DeviceNotificationPreferencesResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Categories holds every category, including the ones unknown to
+		// this package.
+		Categories map[NotificationCategory]DeviceNotifyPref `json:"categories"`
+	} `json:"result"`
+}
+
+type DeviceNotificationPreferencesCall struct {
+	s  *Service
+	id string
+}
+
+// NotificationPreferences retrieves which notification categories are
+// delivered for a device.
+func (r *DeviceService) NotificationPreferences(deviceID string) *DeviceNotificationPreferencesCall {
+	c := &DeviceNotificationPreferencesCall{s: r.s, id: deviceID}
+	return c
+}
+
+func (c *DeviceNotificationPreferencesCall) Do() (*DeviceNotificationPreferencesResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/notification-preferences")
+	ret := &DeviceNotificationPreferencesResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type DeviceSetNotificationPreferencesCall struct {
+	s     *Service
+	id    string
+	prefs DeviceNotifyPrefs
+}
+
+// SetNotificationPreferences overrides the account notification settings
+// for a device. Only the categories in prefs are sent; the others stay
+// unchanged, inherited or not.
+func (r *DeviceService) SetNotificationPreferences(deviceID string, prefs DeviceNotifyPrefs) *DeviceSetNotificationPreferencesCall {
+	c := &DeviceSetNotificationPreferencesCall{s: r.s, id: deviceID, prefs: prefs}
+	return c
+}
+
+func (c *DeviceSetNotificationPreferencesCall) Do() (*DeviceNotificationPreferencesResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/notification-preferences")
+	p := patch{}
+	for cat, on := range c.prefs {
+		p[string(cat)] = on
+	}
+	ret := &DeviceNotificationPreferencesResponse{}
+	_, err := c.s.patch(path, p, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,54 @@
+package account
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_NotificationPreferences(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/devices/d1/notification-preferences")
+		fmt.Fprint(w, `{"code":0,"result":{"categories":{
+			"security_alerts":{"enabled":false,"inherited":false},
+			"product_news":{"enabled":true,"inherited":true},
+			"friend_requests":{"enabled":false,"inherited":true},
+			"firmware_updates":{"enabled":true,"inherited":false}
+		}}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.NotificationPreferences("d1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Categories, DeepEquals, map[NotificationCategory]DeviceNotifyPref{
+		NotifySecurityAlerts:                     {Enabled: false, Inherited: false},
+		NotifyProductNews:                        {Enabled: true, Inherited: true},
+		NotifyFriendRequests:                     {Enabled: false, Inherited: true},
+		NotificationCategory("firmware_updates"): {Enabled: true, Inherited: false},
+	})
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_SetNotificationPreferences(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "PATCH")
+		chk.Check(r.URL.Path, Equals, "/v1.1/devices/d1/notification-preferences")
+		body, _ := io.ReadAll(r.Body)
+		chk.Check(string(body), Equals, `{"product_news":false,"security_alerts":true}`+"\n")
+		fmt.Fprint(w, `{"code":0,"result":{"categories":{
+			"security_alerts":{"enabled":true,"inherited":false},
+			"product_news":{"enabled":false,"inherited":false},
+			"friend_requests":{"enabled":true,"inherited":true}
+		}}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.SetNotificationPreferences("d1", DeviceNotifyPrefs{
+		NotifySecurityAlerts: true,
+		NotifyProductNews:    false,
+	}).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Categories[NotifyFriendRequests], Equals, DeviceNotifyPref{Enabled: true, Inherited: true})
+}
@@ -0,0 +1,132 @@
+package account
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// MappingStatus is the state of a router port mapping. Statuses unknown
+// to this package are kept verbatim.
+type MappingStatus string
+
+const (
+	MappingOK     MappingStatus = "ok"
+	MappingFailed MappingStatus = "failed"
+
+	// MappingUnsupported means the router does not support UPnP port
+	// mapping.
+	MappingUnsupported MappingStatus = "router_unsupported"
+)
+
+// A PortMapping is a router port forwarded to a service of a device.
+type PortMapping struct {
+	Service      string        `json:"service"`
+	InternalPort int           `json:"internal_port"`
+	ExternalPort int           `json:"external_port"`
+	Protocol     string        `json:"protocol"` // "tcp" or "udp"
+	Status       MappingStatus `json:"status"`
+}
+
+type DevicePortMappingsResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Mappings is empty, never nil, when the device has none.
+		Mappings []*PortMapping `json:"mappings"`
+
+		// ProbedAt is when the device last probed its router.
+		ProbedAt time.Time `json:"probed_at"`
+	} `json:"result"`
+}
+
+type DevicePortMappingsCall struct {
+	s   *Service
+	ctx context.Context
+	id  string
+}
+
+// PortMappings lists the router port mappings last reported by a device.
+func (r *DeviceService) PortMappings(deviceID string) *DevicePortMappingsCall {
+	c := &DevicePortMappingsCall{s: r.s, ctx: context.Background(), id: deviceID}
+	return c
+}
+
+// Context sets the context of the call.
+func (c *DevicePortMappingsCall) Context(ctx context.Context) *DevicePortMappingsCall {
+	c.ctx = ctx
+	return c
+}
+
+func (c *DevicePortMappingsCall) Do() (*DevicePortMappingsResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/port-mappings")
+	ret := &DevicePortMappingsResponse{}
+	_, err := c.s.getContext(c.ctx, path, ret)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Result.Mappings == nil {
+		ret.Result.Mappings = []*PortMapping{}
+	}
+	return ret, nil
+}
+
+type DeviceRefreshPortMappingsCall struct {
+	s        *Service
+	ctx      context.Context
+	id       string
+	interval time.Duration
+}
+
+// RefreshPortMappings asks a device to probe its router again, and waits
+// for the device to report the resulting port mappings. Set a deadline
+// with Context to bound the wait.
+func (r *DeviceService) RefreshPortMappings(deviceID string) *DeviceRefreshPortMappingsCall {
+	c := &DeviceRefreshPortMappingsCall{s: r.s, ctx: context.Background(), id: deviceID}
+	return c
+}
+
+// Context sets the context of the call.
+func (c *DeviceRefreshPortMappingsCall) Context(ctx context.Context) *DeviceRefreshPortMappingsCall {
+	c.ctx = ctx
+	return c
+}
+
+// PollInterval sets how often the port mappings are polled.
+func (c *DeviceRefreshPortMappingsCall) PollInterval(d time.Duration) *DeviceRefreshPortMappingsCall {
+	c.interval = d
+	return c
+}
+
+func (c *DeviceRefreshPortMappingsCall) Do() (*DevicePortMappingsResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/port-mappings/probe")
+	probe := &struct {
+		Message string `json:"message"`
+		Code    int    `json:"code"`
+		Result  struct {
+			RequestedAt time.Time `json:"requested_at"`
+		} `json:"result"`
+	}{}
+	req, err := c.s.doRequest("POST", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.s.do(req.WithContext(c.ctx), probe); err != nil {
+		return nil, err
+	}
+
+	list := c.s.Device.PortMappings(c.id).Context(c.ctx)
+	var ret *DevicePortMappingsResponse
+	err = WaitFor(c.ctx, func() (bool, error) {
+		res, err := list.Do()
+		if err != nil {
+			return false, err
+		}
+		ret = res
+		return !res.Result.ProbedAt.Before(probe.Result.RequestedAt), nil
+	}, WaitInterval(c.interval))
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
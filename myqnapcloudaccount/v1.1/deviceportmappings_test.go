@@ -0,0 +1,71 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_PortMappings(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		switch r.URL.Path {
+		case "/v1.1/devices/d1/port-mappings":
+			fmt.Fprint(w, `{"code":0,"result":{"mappings":[
+				{"service":"web","internal_port":8080,"external_port":8080,"protocol":"tcp","status":"ok"},
+				{"service":"ssh","internal_port":22,"external_port":2222,"protocol":"tcp","status":"failed"},
+				{"service":"upnp","internal_port":1900,"external_port":1900,"protocol":"udp","status":"router_unsupported"},
+				{"service":"ftp","internal_port":21,"external_port":21,"protocol":"tcp","status":"conflict"}
+			],"probed_at":"2017-02-23T08:00:00Z"}}`)
+		case "/v1.1/devices/d2/port-mappings":
+			fmt.Fprint(w, `{"code":0,"result":{"mappings":[]}}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.PortMappings("d1").Do()
+	chk.Assert(err, IsNil)
+	m := res.Result.Mappings
+	chk.Assert(m, HasLen, 4)
+	chk.Check(*m[1], Equals, PortMapping{Service: "ssh", InternalPort: 22, ExternalPort: 2222, Protocol: "tcp", Status: MappingFailed})
+	chk.Check(m[0].Status, Equals, MappingOK)
+	chk.Check(m[2].Status, Equals, MappingUnsupported)
+	chk.Check(m[3].Status, Equals, MappingStatus("conflict"))
+	chk.Check(res.Result.ProbedAt.Equal(time.Date(2017, 2, 23, 8, 0, 0, 0, time.UTC)), Equals, true)
+
+	res, err = svc.Device.PortMappings("d2").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Mappings, NotNil)
+	chk.Check(res.Result.Mappings, HasLen, 0)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_RefreshPortMappings(chk *C) {
+	polls := 0
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v1.1/devices/d1/port-mappings/probe":
+			fmt.Fprint(w, `{"code":0,"result":{"requested_at":"2017-02-23T09:00:00Z"}}`)
+		case "GET /v1.1/devices/d1/port-mappings":
+			polls++
+			if polls < 3 {
+				fmt.Fprint(w, `{"code":0,"result":{"mappings":[{"service":"web","status":"failed"}],"probed_at":"2017-02-23T08:00:00Z"}}`)
+				return
+			}
+			fmt.Fprint(w, `{"code":0,"result":{"mappings":[{"service":"web","status":"ok"}],"probed_at":"2017-02-23T09:00:05Z"}}`)
+		default:
+			chk.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := svc.Device.RefreshPortMappings("d1").Context(ctx).PollInterval(time.Millisecond).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(polls, Equals, 3)
+	chk.Assert(res.Result.Mappings, HasLen, 1)
+	chk.Check(res.Result.Mappings[0].Status, Equals, MappingOK)
+}
@@ -0,0 +1,93 @@
+package account
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// AccessLevel is who may reach a device service through myQNAPcloud.
+type AccessLevel string
+
+const (
+	AccessPublic      AccessLevel = "public"
+	AccessPrivate     AccessLevel = "private"
+	AccessFriendsOnly AccessLevel = "friends_only"
+)
+
+// IsKnown reports whether l is one of the documented levels.
+func (l AccessLevel) IsKnown() bool {
+	switch l {
+	case AccessPublic, AccessPrivate, AccessFriendsOnly:
+		return true
+	}
+	return false
+}
+
+type DeviceServicesResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Services is empty, never nil, when the device has none.
+		Services []*NASService `json:"services"`
+	} `json:"result"`
+}
+
+type DeviceServicesCall struct {
+	s  *Service
+	id string
+}
+
+// Services lists the services a device exposes through myQNAPcloud.
+func (r *DeviceService) Services(deviceID string) *DeviceServicesCall {
+	c := &DeviceServicesCall{s: r.s, id: deviceID}
+	return c
+}
+
+func (c *DeviceServicesCall) Do() (*DeviceServicesResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/services")
+	ret := &DeviceServicesResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Result.Services == nil {
+		ret.Result.Services = []*NASService{}
+	}
+	return ret, nil
+}
+
+type DeviceSetServiceAccessResponse struct {
+	Message string     `json:"message"`
+	Code    int        `json:"code"`
+	Result  NASService `json:"result"`
+}
+
+type DeviceSetServiceAccessCall struct {
+	s       *Service
+	id      string
+	service string
+	level   AccessLevel
+}
+
+// SetServiceAccess changes who may reach a service of a device through
+// myQNAPcloud.
+func (r *DeviceService) SetServiceAccess(deviceID, service string, level AccessLevel) *DeviceSetServiceAccessCall {
+	c := &DeviceSetServiceAccessCall{s: r.s, id: deviceID, service: service, level: level}
+	return c
+}
+
+func (c *DeviceSetServiceAccessCall) Do() (*DeviceSetServiceAccessResponse, error) {
+	if !c.level.IsKnown() {
+		return nil, fmt.Errorf("account: invalid access level %q", c.level)
+	}
+	path := versioned("devices/" + url.PathEscape(c.id) + "/services/" + url.PathEscape(c.service) + "/access")
+	payload := struct {
+		Access AccessLevel `json:"access"`
+	}{c.level}
+	ret := &DeviceSetServiceAccessResponse{}
+	_, err := c.s.put(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,52 @@
+package account
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_Services(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/devices/d1/services")
+		fmt.Fprint(w, `{"code":0,"result":{"services":[
+			{"name":"ftp","port":21,"enabled":false,"access":"private"},
+			{"name":"photo_station","port":8080,"enabled":true,"access":"friends_only"},
+			{"name":"web_server","port":80,"enabled":true,"access":"public"},
+			{"name":"container_station","port":8443,"enabled":true,"access":"private"}
+		]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.Services("d1").Do()
+	chk.Assert(err, IsNil)
+	sv := res.Result.Services
+	chk.Assert(sv, HasLen, 4)
+	chk.Check(*sv[0], Equals, NASService{Name: "ftp", Port: 21, Access: AccessPrivate})
+	chk.Check(sv[1].Access, Equals, AccessFriendsOnly)
+	chk.Check(sv[2].Access, Equals, AccessPublic)
+	chk.Check(sv[3].Name, Equals, "container_station")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_SetServiceAccess(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "PUT")
+		chk.Check(r.URL.Path, Equals, "/v1.1/devices/d1/services/container_station/access")
+		var body map[string]string
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		chk.Check(body, DeepEquals, map[string]string{"access": "friends_only"})
+		fmt.Fprint(w, `{"code":0,"result":{"name":"container_station","port":8443,"enabled":true,"access":"friends_only"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.SetServiceAccess("d1", "container_station", AccessFriendsOnly).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Name, Equals, "container_station")
+	chk.Check(res.Result.Access, Equals, AccessFriendsOnly)
+
+	_, err = svc.Device.SetServiceAccess("d1", "container_station", AccessLevel("everyone")).Do()
+	chk.Check(err, ErrorMatches, `account: invalid access level "everyone"`)
+}
@@ -0,0 +1,71 @@
+package account
+
+import "net/url"
+
+// ServiceName identifies a NAS service that can be reached through a
+// SmartURL. Names unknown to this package can be used verbatim.
+type ServiceName string
+
+const (
+	ServiceDesktop      ServiceName = "desktop"
+	ServiceFileStation  ServiceName = "file_station"
+	ServicePhotoStation ServiceName = "photo_station"
+	ServiceMusicStation ServiceName = "music_station"
+	ServiceVideoStation ServiceName = "video_station"
+)
+
+// CandidateKind is the way a SmartURL candidate reaches a device.
+type CandidateKind string
+
+const (
+	CandidateLAN   CandidateKind = "lan"
+	CandidateWAN   CandidateKind = "wan"
+	CandidateRelay CandidateKind = "relay"
+)
+
+// A URLCandidate is a URL of a device service.
+type URLCandidate struct {
+	Kind CandidateKind `json:"kind"`
+	URL  string        `json:"url"`
+
+	// Reachable is whether the service answered on the URL when the API
+	// last checked it.
+	Reachable bool `json:"reachable"`
+}
+
+type DeviceSmartURLResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// URL is the candidate URL the service is best reached at.
+		URL string `json:"url"`
+
+		// Candidates lists all the URLs of the service, in the order they
+		// should be tried.
+		Candidates []*URLCandidate `json:"candidates"`
+	} `json:"result"`
+}
+
+type DeviceSmartURLCall struct {
+	s       *Service
+	id      string
+	service ServiceName
+}
+
+// SmartURL retrieves the URLs at which a service of a device can be
+// reached.
+func (r *DeviceService) SmartURL(deviceID string, service ServiceName) *DeviceSmartURLCall {
+	c := &DeviceSmartURLCall{s: r.s, id: deviceID, service: service}
+	return c
+}
+
+func (c *DeviceSmartURLCall) Do() (*DeviceSmartURLResponse, error) {
+	params := url.Values{"service": {string(c.service)}}
+	path := versioned("devices/"+url.PathEscape(c.id)+"/smart-url") + "?" + params.Encode()
+	ret := &DeviceSmartURLResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
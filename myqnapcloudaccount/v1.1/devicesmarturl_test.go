@@ -0,0 +1,45 @@
+package account
+
+import (
+	"fmt"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_SmartURL(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		switch r.URL.Path {
+		case "/v1.1/devices/d1/smart-url":
+			chk.Check(r.URL.Query().Get("service"), Equals, "file_station")
+			fmt.Fprint(w, `{"code":0,"result":{"url":"https://home.myqnapcloud.com:8081/filestation/","candidates":[
+				{"kind":"lan","url":"https://192.168.1.20:443/filestation/","reachable":false},
+				{"kind":"wan","url":"https://home.myqnapcloud.com:8081/filestation/","reachable":true},
+				{"kind":"relay","url":"https://relay.myqnapcloud.com/d1/filestation/","reachable":true}
+			]}}`)
+		case "/v1.1/devices/d2/smart-url":
+			chk.Check(r.URL.Query().Get("service"), Equals, "surveillance_station")
+			fmt.Fprint(w, `{"code":0,"result":{"url":"https://relay.myqnapcloud.com/d2/surveillance/","candidates":[
+				{"kind":"relay","url":"https://relay.myqnapcloud.com/d2/surveillance/","reachable":true}
+			]}}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.SmartURL("d1", ServiceFileStation).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.URL, Equals, "https://home.myqnapcloud.com:8081/filestation/")
+	c := res.Result.Candidates
+	chk.Assert(c, HasLen, 3)
+	chk.Check(*c[0], Equals, URLCandidate{Kind: CandidateLAN, URL: "https://192.168.1.20:443/filestation/"})
+	chk.Check(c[1].Kind, Equals, CandidateWAN)
+	chk.Check(c[1].Reachable, Equals, true)
+	chk.Check(c[2].Kind, Equals, CandidateRelay)
+
+	res, err = svc.Device.SmartURL("d2", ServiceName("surveillance_station")).Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(res.Result.Candidates, HasLen, 1)
+	chk.Check(res.Result.Candidates[0].Kind, Equals, CandidateRelay)
+	chk.Check(res.Result.URL, Equals, res.Result.Candidates[0].URL)
+}
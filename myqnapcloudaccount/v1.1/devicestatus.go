@@ -0,0 +1,119 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// HeartbeatGrace is how long after its last heartbeat an offline device
+// is reported as DeviceReconnecting rather than DeviceOffline.
+var HeartbeatGrace = 2 * time.Minute
+
+// DeviceState is the connection state of a device.
+type DeviceState string
+
+const (
+	DeviceOnline DeviceState = "online"
+
+	// DeviceReconnecting means the device is offline but sent a
+	// heartbeat less than HeartbeatGrace ago, as while rebooting.
+	DeviceReconnecting DeviceState = "reconnecting"
+	DeviceOffline      DeviceState = "offline"
+)
+
+type DeviceStatusResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Online        bool      `json:"online"`
+		LastHeartbeat time.Time `json:"last_heartbeat"`
+	} `json:"result"`
+
+	// State is the state of the device, derived from Online and
+	// LastHeartbeat.
+	State DeviceState `json:"-"`
+
+	// ETag identifies this version of the status, for IfNoneMatch.
+	ETag string `json:"-"`
+}
+
+type DeviceStatusCall struct {
+	s      *Service
+	ctx    context.Context
+	id     string
+	header http.Header
+}
+
+// Status retrieves the connection status of a device. It is cheaper than
+// Get, and meant for frequent polling.
+func (r *DeviceService) Status(deviceID string) *DeviceStatusCall {
+	c := &DeviceStatusCall{s: r.s, ctx: context.Background(), id: deviceID, header: http.Header{}}
+	return c
+}
+
+// Context sets the context of the call.
+func (c *DeviceStatusCall) Context(ctx context.Context) *DeviceStatusCall {
+	c.ctx = ctx
+	return c
+}
+
+// IfNoneMatch makes the call fail with an error matching ErrNotModified
+// if the status still has the given ETag, as returned by a previous call.
+func (c *DeviceStatusCall) IfNoneMatch(etag string) *DeviceStatusCall {
+	c.header.Set("If-None-Match", etag)
+	return c
+}
+
+func (c *DeviceStatusCall) Do() (*DeviceStatusResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/status")
+	req, err := c.s.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range c.header {
+		req.Header[k] = v
+	}
+	ret := &DeviceStatusResponse{}
+	resp, err := c.s.do(req.WithContext(c.ctx), ret)
+	if err != nil {
+		return nil, err
+	}
+	ret.ETag = resp.Header.Get("ETag")
+	switch {
+	case ret.Result.Online:
+		ret.State = DeviceOnline
+	case !ret.Result.LastHeartbeat.IsZero() && now().Sub(ret.Result.LastHeartbeat) < HeartbeatGrace:
+		ret.State = DeviceReconnecting
+	default:
+		ret.State = DeviceOffline
+	}
+	return ret, nil
+}
+
+// WaitOnline polls the status of a device until it is online, and returns
+// that status.
+func (r *DeviceService) WaitOnline(ctx context.Context, deviceID string, opts ...WaitOption) (*DeviceStatusResponse, error) {
+	var ret *DeviceStatusResponse
+	err := WaitFor(ctx, func() (bool, error) {
+		c := r.Status(deviceID).Context(ctx)
+		if ret != nil && ret.ETag != "" {
+			c.IfNoneMatch(ret.ETag)
+		}
+		res, err := c.Do()
+		if errors.Is(err, ErrNotModified) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		ret = res
+		return res.State == DeviceOnline, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,91 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_Status(chk *C) {
+	defer func(f func() time.Time) { now = f }(now)
+	now = func() time.Time { return time.Date(2017, 2, 23, 8, 1, 0, 0, time.UTC) }
+
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		switch r.URL.Path {
+		case "/v1.1/devices/on/status":
+			w.Header().Set("ETag", `"s1"`)
+			fmt.Fprint(w, `{"code":0,"result":{"online":true,"last_heartbeat":"2017-02-23T08:00:55Z"}}`)
+		case "/v1.1/devices/rebooting/status":
+			fmt.Fprint(w, `{"code":0,"result":{"online":false,"last_heartbeat":"2017-02-23T08:00:00Z"}}`)
+		case "/v1.1/devices/off/status":
+			fmt.Fprint(w, `{"code":0,"result":{"online":false,"last_heartbeat":"2017-02-22T08:00:00Z"}}`)
+		case "/v1.1/devices/new/status":
+			fmt.Fprint(w, `{"code":0,"result":{"online":false}}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.Status("on").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.State, Equals, DeviceOnline)
+	chk.Check(res.ETag, Equals, `"s1"`)
+	chk.Check(res.Result.LastHeartbeat.Equal(time.Date(2017, 2, 23, 8, 0, 55, 0, time.UTC)), Equals, true)
+
+	for id, state := range map[string]DeviceState{
+		"rebooting": DeviceReconnecting,
+		"off":       DeviceOffline,
+		"new":       DeviceOffline,
+	} {
+		res, err := svc.Device.Status(id).Do()
+		chk.Assert(err, IsNil)
+		chk.Check(res.State, Equals, state, Commentf("device %s", id))
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_WaitOnline(chk *C) {
+	defer func(f func() time.Time) { now = f }(now)
+	now = func() time.Time { return time.Date(2017, 2, 23, 8, 1, 0, 0, time.UTC) }
+
+	polls := 0
+	var etags []string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/devices/d1/status")
+		polls++
+		etags = append(etags, r.Header.Get("If-None-Match"))
+		switch {
+		case polls == 1:
+			w.Header().Set("ETag", `"s1"`)
+			fmt.Fprint(w, `{"code":0,"result":{"online":false,"last_heartbeat":"2017-02-23T08:00:00Z"}}`)
+		case polls < 4:
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			w.Header().Set("ETag", `"s2"`)
+			fmt.Fprint(w, `{"code":0,"result":{"online":true,"last_heartbeat":"2017-02-23T08:01:00Z"}}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.WaitOnline(context.Background(), "d1", WaitInterval(time.Millisecond))
+	chk.Assert(err, IsNil)
+	chk.Check(polls, Equals, 4)
+	chk.Check(etags, DeepEquals, []string{"", `"s1"`, `"s1"`, `"s1"`})
+	chk.Check(res.State, Equals, DeviceOnline)
+	chk.Check(res.ETag, Equals, `"s2"`)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_WaitOnline_Timeout(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"code":0,"result":{"online":false}}`)
+	})
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := svc.Device.WaitOnline(ctx, "d1", WaitInterval(time.Millisecond))
+	chk.Check(errors.Is(err, context.DeadlineExceeded), Equals, true)
+}
@@ -0,0 +1,123 @@
+package account
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+// ErrTransferNotEligible is matched by errors.Is when a device cannot be
+// transferred to the requested user, such as a user with too many devices.
+var ErrTransferNotEligible = errors.New("account: user not eligible for device transfer")
+
+const codeTransferNotEligible = 40919
+
+// TransferMethod is how the target of an ownership transfer accepts it.
+type TransferMethod string
+
+const (
+	// TransferByFriend means the target, a friend of the account, accepts
+	// the transfer in the portal.
+	TransferByFriend TransferMethod = "friend"
+
+	// TransferByEmail means the target confirms the transfer through a
+	// link sent to their email address.
+	TransferByEmail TransferMethod = "email"
+)
+
+// TransferState is the progress of an ownership transfer.
+type TransferState string
+
+const (
+	TransferPending   TransferState = "pending"
+	TransferCompleted TransferState = "completed"
+	TransferDeclined  TransferState = "declined"
+	TransferCancelled TransferState = "cancelled"
+	TransferExpired   TransferState = "expired"
+)
+
+// A Transfer is an ownership transfer of a device to another user.
+type Transfer struct {
+	TargetUserID string         `json:"target_user_id"`
+	Method       TransferMethod `json:"method"`
+	State        TransferState  `json:"state"`
+	CreatedAt    time.Time      `json:"created_at"`
+	ExpiresAt    time.Time      `json:"expires_at"`
+}
+
+type DeviceTransferResponse struct {
+	Message string   `json:"message"`
+	Code    int      `json:"code"`
+	Result  Transfer `json:"result"`
+}
+
+type DeviceTransferOwnershipCall struct {
+	s      *Service
+	id     string
+	target string
+}
+
+// TransferOwnership starts transferring a device of the account to
+// another user, who must accept it; Result.Method tells how. Transfers
+// to users who cannot own the device fail with an error matching
+// ErrTransferNotEligible.
+func (r *DeviceService) TransferOwnership(deviceID, targetUserID string) *DeviceTransferOwnershipCall {
+	c := &DeviceTransferOwnershipCall{s: r.s, id: deviceID, target: targetUserID}
+	return c
+}
+
+func (c *DeviceTransferOwnershipCall) Do() (*DeviceTransferResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/transfer")
+	payload := struct {
+		TargetUserID string `json:"target_user_id"`
+	}{c.target}
+	ret := &DeviceTransferResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type DeviceTransferStatusCall struct {
+	s  *Service
+	id string
+}
+
+// TransferStatus retrieves the latest ownership transfer of a device.
+// Devices never transferred yield an error matching ErrNotFound.
+func (r *DeviceService) TransferStatus(deviceID string) *DeviceTransferStatusCall {
+	c := &DeviceTransferStatusCall{s: r.s, id: deviceID}
+	return c
+}
+
+func (c *DeviceTransferStatusCall) Do() (*DeviceTransferResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/transfer")
+	ret := &DeviceTransferResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type DeviceCancelTransferCall struct {
+	s  *Service
+	id string
+}
+
+// CancelTransfer cancels the pending ownership transfer of a device.
+func (r *DeviceService) CancelTransfer(deviceID string) *DeviceCancelTransferCall {
+	c := &DeviceCancelTransferCall{s: r.s, id: deviceID}
+	return c
+}
+
+func (c *DeviceCancelTransferCall) Do() (*DeviceTransferResponse, error) {
+	path := versioned("devices/" + url.PathEscape(c.id) + "/transfer")
+	ret := &DeviceTransferResponse{}
+	_, err := c.s.delete(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
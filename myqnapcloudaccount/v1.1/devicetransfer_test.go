@@ -0,0 +1,65 @@
+package account
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Device_Transfer(chk *C) {
+	state := ""
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/devices/d1/transfer")
+		switch r.Method {
+		case "POST":
+			var body map[string]string
+			chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+			switch body["target_user_id"] {
+			case "u-friend":
+				state = "pending"
+				fmt.Fprint(w, `{"code":0,"result":{"target_user_id":"u-friend","method":"friend","state":"pending",
+					"created_at":"2017-02-23T08:00:00Z","expires_at":"2017-03-02T08:00:00Z"}}`)
+			case "u-other":
+				fmt.Fprint(w, `{"code":0,"result":{"target_user_id":"u-other","method":"email","state":"pending"}}`)
+			default:
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				fmt.Fprint(w, `{"code":40919,"message":"target not eligible"}`)
+			}
+		case "GET":
+			fmt.Fprintf(w, `{"code":0,"result":{"target_user_id":"u-friend","method":"friend","state":%q}}`, state)
+		case "DELETE":
+			state = "cancelled"
+			fmt.Fprintf(w, `{"code":0,"result":{"target_user_id":"u-friend","method":"friend","state":%q}}`, state)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Device.TransferOwnership("d1", "u-friend").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Method, Equals, TransferByFriend)
+	chk.Check(res.Result.State, Equals, TransferPending)
+	chk.Check(res.Result.ExpiresAt.Equal(time.Date(2017, 3, 2, 8, 0, 0, 0, time.UTC)), Equals, true)
+
+	res, err = svc.Device.TransferStatus("d1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.State, Equals, TransferPending)
+
+	res, err = svc.Device.CancelTransfer("d1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.State, Equals, TransferCancelled)
+
+	res, err = svc.Device.TransferStatus("d1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.State, Equals, TransferCancelled)
+
+	res, err = svc.Device.TransferOwnership("d1", "u-other").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Method, Equals, TransferByEmail)
+
+	_, err = svc.Device.TransferOwnership("d1", "u-full").Do()
+	chk.Check(errors.Is(err, ErrTransferNotEligible), Equals, true)
+}
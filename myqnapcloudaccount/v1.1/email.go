@@ -0,0 +1,160 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"time"
+)
+
+var (
+	// ErrEmailTaken is matched by errors.Is when the requested email
+	// address belongs to another account.
+	ErrEmailTaken = errors.New("account: email address already in use")
+
+	// ErrInvalidToken is matched by errors.Is when a confirmation token is
+	// unknown, already used, or expired.
+	ErrInvalidToken = errors.New("account: invalid or expired token")
+)
+
+const (
+	codeInvalidToken = 40001
+	codeEmailTaken   = 40902
+)
+
+// validateEmail checks that addr is a bare email address, without display
+// name or angle brackets.
+func validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil || a.Address != addr {
+		return fmt.Errorf("account: invalid email address %q", addr)
+	}
+	return nil
+}
+
+type EmailService struct {
+	s *Service
+}
+
+func NewEmailService(s *Service) *EmailService {
+	rs := &EmailService{s: s}
+	return rs
+}
+
+type emailChangeRequest struct {
+	NewEmail string `json:"new_email"`
+	Password string `json:"password"`
+}
+
+func (r emailChangeRequest) redacted() interface{} {
+	r.Password = redactedValue
+	return r
+}
+
+type EmailChangeResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		PendingEmail string    `json:"pending_email"`
+		ExpiresAt    time.Time `json:"expires_at"`
+	} `json:"result"`
+}
+
+type EmailChangeRequestCall struct {
+	s   *Service
+	req emailChangeRequest
+}
+
+// ChangeRequest starts changing the account email address to newEmail.
+// The change takes effect once confirmed with the token sent to newEmail.
+func (r *EmailService) ChangeRequest(newEmail, currentPassword string) *EmailChangeRequestCall {
+	c := &EmailChangeRequestCall{s: r.s, req: emailChangeRequest{NewEmail: newEmail, Password: currentPassword}}
+	return c
+}
+
+func (c *EmailChangeRequestCall) Do() (*EmailChangeResponse, error) {
+	if err := validateEmail(c.req.NewEmail); err != nil {
+		return nil, err
+	}
+	path := versioned("me/email/change")
+	ret := &EmailChangeResponse{}
+	_, err := c.s.post(path, c.req, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type EmailConfirmChangeResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Email string `json:"email"`
+	} `json:"result"`
+}
+
+type EmailConfirmChangeCall struct {
+	s     *Service
+	token string
+}
+
+// ConfirmChange completes an email change with the token emailed to the
+// new address.
+func (r *EmailService) ConfirmChange(token string) *EmailConfirmChangeCall {
+	c := &EmailConfirmChangeCall{s: r.s, token: token}
+	return c
+}
+
+func (c *EmailConfirmChangeCall) Do() (*EmailConfirmChangeResponse, error) {
+	path := versioned("me/email/change/confirm")
+	payload := struct {
+		Token string `json:"token"`
+	}{c.token}
+	ret := &EmailConfirmChangeResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+const codeAlreadyVerified = 40903
+
+type EmailResendVerificationResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Seconds to wait before another resend is allowed.
+		CooldownSeconds int `json:"cooldown_seconds"`
+	} `json:"result"`
+
+	// AlreadyVerified is set, and no email sent, when the address was
+	// verified already.
+	AlreadyVerified bool `json:"-"`
+}
+
+type EmailResendVerificationCall struct {
+	s *Service
+}
+
+// ResendVerification sends the verification email of the account address
+// again. When called too often, the returned error matches ErrRateLimited
+// and its ErrorResponse carries the RetryAfter delay.
+func (r *EmailService) ResendVerification() *EmailResendVerificationCall {
+	c := &EmailResendVerificationCall{s: r.s}
+	return c
+}
+
+func (c *EmailResendVerificationCall) Do() (*EmailResendVerificationResponse, error) {
+	path := versioned("me/email/verification/resend")
+	ret := &EmailResendVerificationResponse{}
+	_, err := c.s.post(path, nil, ret)
+	if err != nil {
+		var e *ErrorResponse
+		if errors.As(err, &e) && e.Code == codeAlreadyVerified {
+			return &EmailResendVerificationResponse{Message: e.Message, Code: e.Code, AlreadyVerified: true}, nil
+		}
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,122 @@
+package account
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Email_ChangeRequest(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/email/change")
+		var body map[string]string
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		chk.Check(body, DeepEquals, map[string]string{"new_email": "new@example.com", "password": "s3cret!"})
+		fmt.Fprint(w, `{"code":0,"result":{"pending_email":"new@example.com","expires_at":"2017-02-24T08:00:00Z"}}`)
+	})
+	defer ts.Close()
+
+	logs := &bytes.Buffer{}
+	log.SetOutput(logs)
+	defer log.SetOutput(os.Stderr)
+	svc.Debug = true
+
+	res, err := svc.Me.Email.ChangeRequest("new@example.com", "s3cret!").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.PendingEmail, Equals, "new@example.com")
+	chk.Check(res.Result.ExpiresAt.IsZero(), Equals, false)
+	chk.Check(strings.Contains(logs.String(), "new@example.com"), Equals, true)
+	chk.Check(strings.Contains(logs.String(), "s3cret!"), Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Email_ChangeRequest_Invalid(chk *C) {
+	svc := New(nil)
+	for _, addr := range []string{"", "not-an-email", "Bob <bob@example.com>"} {
+		_, err := svc.Me.Email.ChangeRequest(addr, "pw").Do()
+		chk.Check(err, ErrorMatches, "account: invalid email address .*")
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Email_ChangeRequest_Taken(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"code":40902,"message":"email already in use"}`)
+	})
+	defer ts.Close()
+
+	_, err := svc.Me.Email.ChangeRequest("taken@example.com", "pw").Do()
+	chk.Check(errors.Is(err, ErrEmailTaken), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Email_ConfirmChange(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/email/change/confirm")
+		var body map[string]string
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		if body["token"] != "t0k3n" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"code":40001,"message":"invalid token"}`)
+			return
+		}
+		fmt.Fprint(w, `{"code":0,"result":{"email":"new@example.com"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Email.ConfirmChange("t0k3n").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Email, Equals, "new@example.com")
+
+	_, err = svc.Me.Email.ConfirmChange("stale").Do()
+	chk.Check(errors.Is(err, ErrInvalidToken), Equals, true)
+	chk.Check(errors.Is(err, ErrEmailTaken), Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Email_ResendVerification(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/email/verification/resend")
+		fmt.Fprint(w, `{"code":0,"result":{"cooldown_seconds":60}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Email.ResendVerification().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.AlreadyVerified, Equals, false)
+	chk.Check(res.Result.CooldownSeconds, Equals, 60)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Email_ResendVerification_AlreadyVerified(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"code":40903,"message":"email already verified"}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Email.ResendVerification().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.AlreadyVerified, Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Email_ResendVerification_Cooldown(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "42")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"code":429,"message":"too many requests"}`)
+	})
+	defer ts.Close()
+
+	_, err := svc.Me.Email.ResendVerification().Do()
+	chk.Check(errors.Is(err, ErrRateLimited), Equals, true)
+	var e *ErrorResponse
+	chk.Assert(errors.As(err, &e), Equals, true)
+	chk.Check(e.RetryAfter, Equals, 42*time.Second)
+}
@@ -0,0 +1,568 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	// ErrAlreadyFriends is matched by errors.Is when inviting a user who
+	// is already a friend.
+	ErrAlreadyFriends = errors.New("account: already friends")
+
+	// ErrInvitationPending is matched by errors.Is when an invitation to
+	// the same user is already pending.
+	ErrInvitationPending = errors.New("account: invitation already pending")
+)
+
+const (
+	codeAlreadyFriends  = 40907
+	codeInvitePending   = 40908
+	codeFriendshipEnded = 40410
+)
+
+// InvitationState is the state of a friend invitation.
+type InvitationState string
+
+const (
+	InvitationPending   InvitationState = "pending"
+	InvitationAccepted  InvitationState = "accepted"
+	InvitationDeclined  InvitationState = "declined"
+	InvitationCancelled InvitationState = "cancelled"
+)
+
+// A Friend is a user in the friend list of the account.
+type Friend struct {
+	UserID      string    `json:"user_id"`
+	DisplayName string    `json:"display_name"`
+	Email       string    `json:"email"`
+	AvatarURL   string    `json:"avatar_url"`
+	FriendedAt  time.Time `json:"friended_at"`
+
+	// Alias is the private nickname given to the friend by the account,
+	// empty if none. See SetAlias.
+	Alias string `json:"alias"`
+}
+
+type FriendListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Friends is empty, never nil, when the account has no friends.
+		Friends       []*Friend `json:"friends"`
+		NextPageToken string    `json:"next_page_token"`
+	} `json:"result"`
+}
+
+type FriendListCall struct {
+	s      *Service
+	params url.Values
+}
+
+// List lists the friends of the account.
+func (r *FriendService) List() *FriendListCall {
+	c := &FriendListCall{s: r.s, params: url.Values{}}
+	return c
+}
+
+// PageSize sets the maximum number of friends returned per page.
+func (c *FriendListCall) PageSize(n int) *FriendListCall {
+	c.params.Set("page_size", strconv.Itoa(n))
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *FriendListCall) PageToken(token string) *FriendListCall {
+	if token == "" {
+		c.params.Del("page_token")
+		return c
+	}
+	c.params.Set("page_token", token)
+	return c
+}
+
+func (c *FriendListCall) Do() (*FriendListResponse, error) {
+	return c.doContext(context.Background())
+}
+
+func (c *FriendListCall) doContext(ctx context.Context) (*FriendListResponse, error) {
+	path := versioned("friends")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &FriendListResponse{}
+	_, err := c.s.getContext(ctx, path, ret)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Result.Friends == nil {
+		ret.Result.Friends = []*Friend{}
+	}
+	return ret, nil
+}
+
+// Pages calls f for each page of the listing, from the page set with
+// PageToken on; see pages for the details.
+func (c *FriendListCall) Pages(ctx context.Context, f func(*FriendListResponse) error) error {
+	return pages(ctx, c.params, func() (string, error) {
+		res, err := c.doContext(ctx)
+		if err != nil {
+			return "", err
+		}
+		return res.Result.NextPageToken, f(res)
+	})
+}
+
+// minSearchQuery is the shortest query accepted by Search, in characters.
+const minSearchQuery = 2
+
+type FriendSearchCall struct {
+	list  *FriendListCall
+	query string
+}
+
+// Search lists the friends whose name or email address matches query,
+// which must be at least 2 characters long. Results are paged as for
+// List.
+func (r *FriendService) Search(query string) *FriendSearchCall {
+	c := &FriendSearchCall{list: r.List(), query: query}
+	c.list.params.Set("q", query)
+	return c
+}
+
+// PageSize sets the maximum number of friends returned per page.
+func (c *FriendSearchCall) PageSize(n int) *FriendSearchCall {
+	c.list.PageSize(n)
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *FriendSearchCall) PageToken(token string) *FriendSearchCall {
+	c.list.PageToken(token)
+	return c
+}
+
+func (c *FriendSearchCall) validate() error {
+	if utf8.RuneCountInString(c.query) < minSearchQuery {
+		return fmt.Errorf("account: search query must be at least %d characters long", minSearchQuery)
+	}
+	return nil
+}
+
+func (c *FriendSearchCall) Do() (*FriendListResponse, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	return c.list.Do()
+}
+
+// Pages calls f for each page of the results, as FriendListCall.Pages.
+func (c *FriendSearchCall) Pages(ctx context.Context, f func(*FriendListResponse) error) error {
+	if err := c.validate(); err != nil {
+		return err
+	}
+	return c.list.Pages(ctx, f)
+}
+
+type FriendInviteResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		InvitationID string          `json:"invitation_id"`
+		State        InvitationState `json:"state"`
+	} `json:"result"`
+}
+
+type FriendInviteCall struct {
+	s       *Service
+	email   string
+	userID  string
+	message string
+}
+
+// Invite sends a friend invitation. The invited user is set with either
+// Email or UserID.
+func (r *FriendService) Invite() *FriendInviteCall {
+	c := &FriendInviteCall{s: r.s}
+	return c
+}
+
+// Email invites the user registered with addr. Unregistered addresses
+// receive an invitation to sign up.
+func (c *FriendInviteCall) Email(addr string) *FriendInviteCall {
+	c.email = addr
+	return c
+}
+
+// UserID invites the user with the given QID or user ID.
+func (c *FriendInviteCall) UserID(qid string) *FriendInviteCall {
+	c.userID = qid
+	return c
+}
+
+// Message sets a personal note shown with the invitation.
+func (c *FriendInviteCall) Message(text string) *FriendInviteCall {
+	c.message = text
+	return c
+}
+
+func (c *FriendInviteCall) Do() (*FriendInviteResponse, error) {
+	if err := (InviteTarget{Email: c.email, UserID: c.userID}).validate(); err != nil {
+		return nil, err
+	}
+	path := versioned("friends/invitations")
+	payload := struct {
+		Email   string `json:"email,omitempty"`
+		UserID  string `json:"user_id,omitempty"`
+		Message string `json:"message,omitempty"`
+	}{c.email, c.userID, c.message}
+	ret := &FriendInviteResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type FriendRemoveCall struct {
+	s  *Service
+	id string
+}
+
+// Remove ends the friendship with a user. Removing a friendship that
+// already ended succeeds; the returned error matches ErrNotFound if the
+// users never were friends, and ErrForbidden if the friendship cannot be
+// removed by the account.
+func (r *FriendService) Remove(friendUserID string) *FriendRemoveCall {
+	c := &FriendRemoveCall{s: r.s, id: friendUserID}
+	return c
+}
+
+func (c *FriendRemoveCall) Do() error {
+	path := versioned("friends/" + url.PathEscape(c.id))
+	_, err := c.s.delete(path, nil, nil)
+	var e *ErrorResponse
+	if errors.As(err, &e) && e.Code == codeFriendshipEnded {
+		return nil
+	}
+	return err
+}
+
+// MaxBatchInvite is the maximum number of targets the API accepts per
+// batch invitation request. BatchInvite splits larger batches.
+const MaxBatchInvite = 50
+
+// An InviteTarget is a user to invite, set by either Email or UserID.
+type InviteTarget struct {
+	Email  string `json:"email,omitempty"`
+	UserID string `json:"user_id,omitempty"`
+}
+
+func (t InviteTarget) validate() error {
+	if (t.Email == "") == (t.UserID == "") {
+		return errors.New("account: invitation needs exactly one of Email or UserID")
+	}
+	if t.Email != "" {
+		return validateEmail(t.Email)
+	}
+	return nil
+}
+
+// An InviteResult is the outcome of inviting one target of a batch.
+type InviteResult struct {
+	Target       InviteTarget    `json:"target"`
+	InvitationID string          `json:"invitation_id"`
+	State        InvitationState `json:"state"`
+
+	// Err is nil if the invitation was sent.
+	Err *ItemError `json:"error"`
+
+	// Duplicate is set when the target appeared earlier in the batch;
+	// the result is then a copy of the earlier one and no other
+	// invitation was sent.
+	Duplicate bool `json:"-"`
+}
+
+type FriendBatchInviteResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Results holds an entry per target, in the order of the input.
+		// When Do fails part way, the entries of the targets not sent
+		// yet are nil.
+		Results []*InviteResult `json:"results"`
+	} `json:"result"`
+}
+
+type FriendBatchInviteCall struct {
+	s       *Service
+	targets []InviteTarget
+	message string
+}
+
+// BatchInvite sends friend invitations to several users at once.
+//
+// Batches larger than MaxBatchInvite are sent in several requests. If one
+// of them fails, Do returns the error along with the results of the
+// requests that succeeded before it, so that a retry can skip the users
+// invited already.
+func (r *FriendService) BatchInvite(targets []InviteTarget) *FriendBatchInviteCall {
+	c := &FriendBatchInviteCall{s: r.s, targets: targets}
+	return c
+}
+
+// Message sets a personal note shown with each invitation.
+func (c *FriendBatchInviteCall) Message(text string) *FriendBatchInviteCall {
+	c.message = text
+	return c
+}
+
+func (c *FriendBatchInviteCall) Do() (*FriendBatchInviteResponse, error) {
+	var unique []InviteTarget
+	first := make(map[InviteTarget]int, len(c.targets))
+	for _, t := range c.targets {
+		if err := t.validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := first[t]; !ok {
+			first[t] = len(unique)
+			unique = append(unique, t)
+		}
+	}
+
+	path := versioned("friends/invitations/batch")
+	sent := make([]*InviteResult, 0, len(unique))
+	ret := &FriendBatchInviteResponse{}
+	var err error
+	for len(sent) < len(unique) {
+		chunk := unique[len(sent):]
+		if len(chunk) > MaxBatchInvite {
+			chunk = chunk[:MaxBatchInvite]
+		}
+		payload := struct {
+			Targets []InviteTarget `json:"targets"`
+			Message string         `json:"message,omitempty"`
+		}{chunk, c.message}
+		res := &FriendBatchInviteResponse{}
+		_, err = c.s.post(path, payload, res)
+		if err == nil && len(res.Result.Results) != len(chunk) {
+			err = fmt.Errorf("account: batch invitation returned %d results for %d targets", len(res.Result.Results), len(chunk))
+		}
+		if err != nil {
+			break
+		}
+		for i, r := range res.Result.Results {
+			r.Target = chunk[i]
+			sent = append(sent, r)
+		}
+		ret = res
+	}
+
+	ret.Result.Results = make([]*InviteResult, len(c.targets))
+	seen := make(map[InviteTarget]bool, len(unique))
+	for i, t := range c.targets {
+		if first[t] >= len(sent) {
+			continue
+		}
+		r := sent[first[t]]
+		if seen[t] {
+			dup := *r
+			dup.Duplicate = true
+			r = &dup
+		}
+		seen[t] = true
+		ret.Result.Results[i] = r
+	}
+	return ret, err
+}
+
+// A FriendDetail is the full record of a friend.
+type FriendDetail struct {
+	Friend
+
+	SharedDeviceCount  int `json:"shared_device_count"`
+	MutualFriendsCount int `json:"mutual_friends_count"`
+
+	// Groups lists the groups of the account the friend is in, with their
+	// ID and Name set. It is nil when the friend is in none.
+	Groups []*FriendGroup `json:"groups"`
+}
+
+type FriendGetResponse struct {
+	Message string       `json:"message"`
+	Code    int          `json:"code"`
+	Result  FriendDetail `json:"result"`
+}
+
+type FriendGetCall struct {
+	s  *Service
+	id string
+}
+
+// Get retrieves the detail record of a friend. Users who are not friends
+// of the account yield an error matching ErrNotFound.
+func (r *FriendService) Get(friendUserID string) *FriendGetCall {
+	c := &FriendGetCall{s: r.s, id: friendUserID}
+	return c
+}
+
+func (c *FriendGetCall) Do() (*FriendGetResponse, error) {
+	path := versioned("friends/" + url.PathEscape(c.id))
+	ret := &FriendGetResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type FriendSummaryResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Friends         int `json:"friends"`
+		PendingSent     int `json:"pending_sent"`
+		PendingReceived int `json:"pending_received"`
+		Blocked         int `json:"blocked"`
+
+		// ChangedAt is when any of the counts last changed, zero for an
+		// account that never had any.
+		ChangedAt time.Time `json:"changed_at"`
+	} `json:"result"`
+
+	// ETag identifies this version of the summary, for IfNoneMatch.
+	ETag string `json:"-"`
+}
+
+type FriendSummaryCall struct {
+	s      *Service
+	header http.Header
+}
+
+// Summary retrieves the friend, invitation and block counts of the
+// account.
+func (r *FriendService) Summary() *FriendSummaryCall {
+	c := &FriendSummaryCall{s: r.s, header: http.Header{}}
+	return c
+}
+
+// IfNoneMatch makes the call fail with an error matching ErrNotModified
+// if the summary still has the given ETag, as returned by a previous call.
+func (c *FriendSummaryCall) IfNoneMatch(etag string) *FriendSummaryCall {
+	c.header.Set("If-None-Match", etag)
+	return c
+}
+
+func (c *FriendSummaryCall) Do() (*FriendSummaryResponse, error) {
+	path := versioned("friends/summary")
+	ret := &FriendSummaryResponse{}
+	resp, err := c.s.getWithHeader(path, c.header, ret)
+	if err != nil {
+		return nil, err
+	}
+	ret.ETag = resp.Header.Get("ETag")
+	return ret, nil
+}
+
+const maxAlias = 32
+
+type FriendSetAliasResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  Friend `json:"result"`
+}
+
+type FriendSetAliasCall struct {
+	s     *Service
+	id    string
+	alias string
+}
+
+// SetAlias gives a friend a private nickname, of at most 32 characters,
+// seen only by the account. An empty alias clears it.
+func (r *FriendService) SetAlias(friendUserID, alias string) *FriendSetAliasCall {
+	c := &FriendSetAliasCall{s: r.s, id: friendUserID, alias: alias}
+	return c
+}
+
+func (c *FriendSetAliasCall) Do() (*FriendSetAliasResponse, error) {
+	if utf8.RuneCountInString(c.alias) > maxAlias {
+		return nil, fmt.Errorf("account: alias must be at most %d characters long", maxAlias)
+	}
+	path := versioned("friends/" + url.PathEscape(c.id) + "/alias")
+	payload := struct {
+		Alias string `json:"alias"`
+	}{c.alias}
+	ret := &FriendSetAliasResponse{}
+	_, err := c.s.put(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// A SharedDevice is a device shared between the account and a friend.
+type SharedDevice struct {
+	DeviceID string `json:"device_id"`
+	Name     string `json:"name"`
+
+	// Direction is DirectionSent for devices of the account shared with
+	// the friend, DirectionReceived for devices of the friend shared with
+	// the account.
+	Direction Direction  `json:"direction"`
+	MyRole    DeviceRole `json:"my_role"`
+	TheirRole DeviceRole `json:"their_role"`
+}
+
+type FriendSharedDevicesResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Devices is empty, never nil, when no device is shared.
+		Devices []*SharedDevice `json:"devices"`
+	} `json:"result"`
+}
+
+type FriendSharedDevicesCall struct {
+	s      *Service
+	id     string
+	params url.Values
+}
+
+// SharedDevices lists the devices shared between the account and a
+// friend, in both directions unless set otherwise with Direction.
+func (r *FriendService) SharedDevices(friendUserID string) *FriendSharedDevicesCall {
+	c := &FriendSharedDevicesCall{s: r.s, id: friendUserID, params: url.Values{}}
+	return c
+}
+
+// Direction restricts the results to the devices shared with the friend
+// (DirectionSent) or by the friend (DirectionReceived).
+func (c *FriendSharedDevicesCall) Direction(d Direction) *FriendSharedDevicesCall {
+	c.params.Set("direction", string(d))
+	return c
+}
+
+func (c *FriendSharedDevicesCall) Do() (*FriendSharedDevicesResponse, error) {
+	path := versioned("friends/" + url.PathEscape(c.id) + "/shared-devices")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &FriendSharedDevicesResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Result.Devices == nil {
+		ret.Result.Devices = []*SharedDevice{}
+	}
+	return ret, nil
+}
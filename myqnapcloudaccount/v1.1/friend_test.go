@@ -0,0 +1,486 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_List(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends")
+		chk.Check(r.URL.Query().Get("page_size"), Equals, "2")
+		fmt.Fprint(w, `{"code":0,"result":{"friends":[
+			{"user_id":"u1","display_name":"Alice","email":"alice@example.com","avatar_url":"https://cdn.example.com/a.png","friended_at":"2016-05-01T10:00:00Z"},
+			{"user_id":"u2","display_name":"Bob","email":"bob@example.com","friended_at":"2016-06-01T10:00:00Z"}
+		],"next_page_token":"p2"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.List().PageSize(2).Do()
+	chk.Assert(err, IsNil)
+	f := res.Result.Friends
+	chk.Assert(f, HasLen, 2)
+	chk.Check(f[0].UserID, Equals, "u1")
+	chk.Check(f[0].AvatarURL, Equals, "https://cdn.example.com/a.png")
+	chk.Check(f[0].FriendedAt.Equal(time.Date(2016, 5, 1, 10, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(f[1].AvatarURL, Equals, "")
+	chk.Check(res.Result.NextPageToken, Equals, "p2")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_ListEmpty(chk *C) {
+	for _, body := range []string{`{"code":0,"result":{"friends":[]}}`, `{"code":0,"result":{}}`} {
+		svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, body)
+		})
+		res, err := svc.Friend.List().Do()
+		ts.Close()
+		chk.Assert(err, IsNil)
+		chk.Check(res.Result.Friends, NotNil)
+		chk.Check(res.Result.Friends, HasLen, 0)
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_ListPages(chk *C) {
+	pageOf := map[string]string{
+		"":   `{"code":0,"result":{"friends":[{"user_id":"u1"},{"user_id":"u2"}],"next_page_token":"p2"}}`,
+		"p2": `{"code":0,"result":{"friends":[{"user_id":"u3"},{"user_id":"u4"}],"next_page_token":"p3"}}`,
+		"p3": `{"code":0,"result":{"friends":[{"user_id":"u5"}]}}`,
+	}
+	var tokens []string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("page_token")
+		tokens = append(tokens, token)
+		fmt.Fprint(w, pageOf[token])
+	})
+	defer ts.Close()
+
+	var ids []string
+	call := svc.Friend.List().PageSize(2)
+	err := call.Pages(context.Background(), func(res *FriendListResponse) error {
+		for _, f := range res.Result.Friends {
+			ids = append(ids, f.UserID)
+		}
+		return nil
+	})
+	chk.Assert(err, IsNil)
+	chk.Check(ids, DeepEquals, []string{"u1", "u2", "u3", "u4", "u5"})
+	chk.Check(tokens, DeepEquals, []string{"", "p2", "p3"})
+
+	// Pages resumes from PageToken and stops on the callback error.
+	stop := errors.New("stop")
+	ids, tokens = nil, nil
+	err = call.PageToken("p2").Pages(context.Background(), func(res *FriendListResponse) error {
+		ids = append(ids, res.Result.Friends[0].UserID)
+		return stop
+	})
+	chk.Check(err, Equals, stop)
+	chk.Check(ids, DeepEquals, []string{"u3"})
+	chk.Check(tokens, DeepEquals, []string{"p2"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = svc.Friend.List().Pages(ctx, func(*FriendListResponse) error { return nil })
+	chk.Check(err, Equals, context.Canceled)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_Invite(chk *C) {
+	var body string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends/invitations")
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		fmt.Fprint(w, `{"code":0,"result":{"invitation_id":"inv-1","state":"pending"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Invite().Email("carol@example.com").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(body, Equals, `{"email":"carol@example.com"}`+"\n")
+	chk.Check(res.Result.InvitationID, Equals, "inv-1")
+	chk.Check(res.Result.State, Equals, InvitationPending)
+
+	_, err = svc.Friend.Invite().UserID("Q1234567").Message("Hi, it's Dave").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(body, Equals, `{"user_id":"Q1234567","message":"Hi, it's Dave"}`+"\n")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_InviteValidation(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Error("unexpected request")
+	})
+	defer ts.Close()
+
+	_, err := svc.Friend.Invite().Do()
+	chk.Check(err, ErrorMatches, "account: invitation needs exactly one of Email or UserID")
+	_, err = svc.Friend.Invite().Email("carol@example.com").UserID("Q1234567").Do()
+	chk.Check(err, ErrorMatches, "account: invitation needs exactly one of Email or UserID")
+	_, err = svc.Friend.Invite().Email("carol").Do()
+	chk.Check(err, ErrorMatches, "account: invalid email address .*")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_InviteConflicts(chk *C) {
+	code := 0
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprintf(w, `{"code":%d,"message":"conflict"}`, code)
+	})
+	defer ts.Close()
+
+	code = 40907
+	_, err := svc.Friend.Invite().UserID("Q1234567").Do()
+	chk.Check(errors.Is(err, ErrAlreadyFriends), Equals, true)
+	chk.Check(errors.Is(err, ErrInvitationPending), Equals, false)
+
+	code = 40908
+	_, err = svc.Friend.Invite().UserID("Q1234567").Do()
+	chk.Check(errors.Is(err, ErrInvitationPending), Equals, true)
+	chk.Check(errors.Is(err, ErrAlreadyFriends), Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_Remove(chk *C) {
+	friends := map[string]bool{"u1": true, "a/b c?": true}
+	removed := map[string]bool{}
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "DELETE")
+		id := r.URL.Path[len("/v1.1/friends/"):]
+		switch {
+		case id == "locked":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"code":40300,"message":"managed friendship"}`)
+		case friends[id]:
+			delete(friends, id)
+			removed[id] = true
+			w.WriteHeader(http.StatusNoContent)
+		case removed[id]:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":40410,"message":"friendship already ended"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":40400,"message":"not friends"}`)
+		}
+	})
+	defer ts.Close()
+
+	chk.Check(svc.Friend.Remove("u1").Do(), IsNil)
+	chk.Check(svc.Friend.Remove("u1").Do(), IsNil)
+	chk.Check(svc.Friend.Remove("a/b c?").Do(), IsNil)
+	chk.Check(removed["a/b c?"], Equals, true)
+
+	err := svc.Friend.Remove("u2").Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+	chk.Check(errors.Is(err, ErrForbidden), Equals, false)
+
+	err = svc.Friend.Remove("locked").Do()
+	chk.Check(errors.Is(err, ErrForbidden), Equals, true)
+	chk.Check(errors.Is(err, ErrNotFound), Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_Search(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends")
+		chk.Check(r.URL.RawQuery, Equals, "page_size=10&q=R%26D+%23%E7%A0%94%E7%99%BC")
+		chk.Check(r.URL.Query().Get("q"), Equals, "R&D #研發")
+		fmt.Fprint(w, `{"code":0,"result":{"friends":[
+			{"user_id":"u3","display_name":"R&D #研發 Lab","email":"lab@example.com","friended_at":"2016-07-01T10:00:00Z"}
+		]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Search("R&D #研發").PageSize(10).Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(res.Result.Friends, HasLen, 1)
+	chk.Check(res.Result.Friends[0].UserID, Equals, "u3")
+	chk.Check(res.Result.Friends[0].DisplayName, Equals, "R&D #研發 Lab")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_SearchTooShort(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Error("unexpected request")
+	})
+	defer ts.Close()
+
+	for _, q := range []string{"", "a", "研"} {
+		_, err := svc.Friend.Search(q).Do()
+		chk.Check(err, ErrorMatches, "account: search query must be at least 2 characters long")
+	}
+	err := svc.Friend.Search("a").Pages(context.Background(), func(*FriendListResponse) error { return nil })
+	chk.Check(err, NotNil)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_BatchInvite(chk *C) {
+	var chunks []int
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends/invitations/batch")
+		var body struct {
+			Targets []InviteTarget `json:"targets"`
+			Message string         `json:"message"`
+		}
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		chk.Check(body.Message, Equals, "Welcome to the team")
+		chunks = append(chunks, len(body.Targets))
+		res := FriendBatchInviteResponse{}
+		for _, t := range body.Targets {
+			ir := &InviteResult{InvitationID: "inv-" + t.Email + t.UserID, State: InvitationPending}
+			switch t.UserID {
+			case "friend":
+				ir = &InviteResult{Err: &ItemError{Code: 40907, Message: "already friends"}}
+			case "pending":
+				ir = &InviteResult{Err: &ItemError{Code: 40908, Message: "invitation already pending"}}
+			}
+			res.Result.Results = append(res.Result.Results, ir)
+		}
+		json.NewEncoder(w).Encode(res)
+	})
+	defer ts.Close()
+
+	var targets []InviteTarget
+	for i := 0; i < MaxBatchInvite+5; i++ {
+		targets = append(targets, InviteTarget{Email: fmt.Sprintf("user%d@example.com", i)})
+	}
+	targets = append(targets,
+		InviteTarget{UserID: "friend"},
+		InviteTarget{Email: "user3@example.com"},
+		InviteTarget{UserID: "pending"},
+	)
+
+	res, err := svc.Friend.BatchInvite(targets).Message("Welcome to the team").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(chunks, DeepEquals, []int{MaxBatchInvite, 7})
+
+	r := res.Result.Results
+	chk.Assert(r, HasLen, len(targets))
+	for i, t := range targets {
+		chk.Check(r[i].Target, Equals, t)
+	}
+	chk.Check(r[0].InvitationID, Equals, "inv-user0@example.com")
+	chk.Check(r[54].InvitationID, Equals, "inv-user54@example.com")
+	chk.Check(r[55].Err, NotNil)
+	chk.Check(errors.Is(r[55].Err, ErrAlreadyFriends), Equals, true)
+	chk.Check(r[56].Duplicate, Equals, true)
+	chk.Check(r[56].InvitationID, Equals, "inv-user3@example.com")
+	chk.Check(r[3].Duplicate, Equals, false)
+	chk.Check(errors.Is(r[57].Err, ErrInvitationPending), Equals, true)
+
+	_, err = svc.Friend.BatchInvite([]InviteTarget{{Email: "a@example.com", UserID: "u1"}}).Do()
+	chk.Check(err, ErrorMatches, "account: invitation needs exactly one of Email or UserID")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_BatchInvite_ChunkFailure(chk *C) {
+	var chunks int
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Targets []InviteTarget `json:"targets"`
+		}
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		chunks++
+		if chunks == 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"code":50000,"message":"internal error"}`)
+			return
+		}
+		res := FriendBatchInviteResponse{}
+		for _, t := range body.Targets {
+			res.Result.Results = append(res.Result.Results, &InviteResult{InvitationID: "inv-" + t.Email, State: InvitationPending})
+		}
+		json.NewEncoder(w).Encode(res)
+	})
+	defer ts.Close()
+
+	var targets []InviteTarget
+	for i := 0; i < MaxBatchInvite+5; i++ {
+		targets = append(targets, InviteTarget{Email: fmt.Sprintf("user%d@example.com", i)})
+	}
+	targets = append(targets, InviteTarget{Email: "user0@example.com"})
+
+	res, err := svc.Friend.BatchInvite(targets).Do()
+	chk.Assert(err, NotNil)
+	chk.Check(chunks, Equals, 2)
+
+	// The invitations of the first chunk are sent and still reported.
+	chk.Assert(res, NotNil)
+	r := res.Result.Results
+	chk.Assert(r, HasLen, len(targets))
+	chk.Check(r[0].InvitationID, Equals, "inv-user0@example.com")
+	chk.Check(r[MaxBatchInvite-1].InvitationID, Equals, fmt.Sprintf("inv-user%d@example.com", MaxBatchInvite-1))
+	chk.Check(r[MaxBatchInvite], IsNil)
+	chk.Check(r[MaxBatchInvite+5].Duplicate, Equals, true)
+	chk.Check(r[MaxBatchInvite+5].InvitationID, Equals, "inv-user0@example.com")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_Get(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		switch r.URL.Path {
+		case "/v1.1/friends/u1":
+			fmt.Fprint(w, `{"code":0,"result":{"user_id":"u1","display_name":"Alice","email":"alice@example.com",
+				"avatar_url":"https://cdn.example.com/a.png","friended_at":"2016-05-01T10:00:00Z",
+				"shared_device_count":2,"mutual_friends_count":5,
+				"groups":[{"id":"g1","name":"Family"},{"id":"g2","name":"Work"}]}}`)
+		case "/v1.1/friends/u2":
+			fmt.Fprint(w, `{"code":0,"result":{"user_id":"u2","display_name":"Bob"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":40400,"message":"not a friend"}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Get("u1").Do()
+	chk.Assert(err, IsNil)
+	d := res.Result
+	chk.Check(d.UserID, Equals, "u1")
+	chk.Check(d.Email, Equals, "alice@example.com")
+	chk.Check(d.FriendedAt.Equal(time.Date(2016, 5, 1, 10, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(d.SharedDeviceCount, Equals, 2)
+	chk.Check(d.MutualFriendsCount, Equals, 5)
+	chk.Assert(d.Groups, HasLen, 2)
+	chk.Check(d.Groups[1].Name, Equals, "Work")
+
+	res, err = svc.Friend.Get("u2").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.DisplayName, Equals, "Bob")
+	chk.Check(res.Result.SharedDeviceCount, Equals, 0)
+	chk.Check(res.Result.Groups, IsNil)
+
+	_, err = svc.Friend.Get("u3").Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_Summary(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends/summary")
+		if r.Header.Get("If-None-Match") == `"v42"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v42"`)
+		fmt.Fprint(w, `{"code":0,"result":{"friends":42,"pending_sent":1,"pending_received":3,"blocked":1,"changed_at":"2017-02-23T08:00:00Z"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Summary().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Friends, Equals, 42)
+	chk.Check(res.Result.PendingSent, Equals, 1)
+	chk.Check(res.Result.PendingReceived, Equals, 3)
+	chk.Check(res.Result.Blocked, Equals, 1)
+	chk.Check(res.Result.ChangedAt.Equal(time.Date(2017, 2, 23, 8, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(res.ETag, Equals, `"v42"`)
+
+	_, err = svc.Friend.Summary().IfNoneMatch(res.ETag).Do()
+	chk.Check(errors.Is(err, ErrNotModified), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_SummaryNewAccount(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"code":0,"result":{"friends":0,"pending_sent":0,"pending_received":0,"blocked":0}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Summary().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Friends+res.Result.PendingSent+res.Result.PendingReceived+res.Result.Blocked, Equals, 0)
+	chk.Check(res.Result.ChangedAt.IsZero(), Equals, true)
+	chk.Check(res.ETag, Equals, "")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_SetAlias(chk *C) {
+	var body string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "PUT")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends/u1/alias")
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		var p struct{ Alias string }
+		json.Unmarshal(b, &p)
+		fmt.Fprintf(w, `{"code":0,"result":{"user_id":"u1","display_name":"Alice","alias":%q}}`, p.Alias)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.SetAlias("u1", "Dad's NAS").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(body, Equals, `{"alias":"Dad's NAS"}`+"\n")
+	chk.Check(res.Result.Alias, Equals, "Dad's NAS")
+
+	res, err = svc.Friend.SetAlias("u1", "").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(body, Equals, `{"alias":""}`+"\n")
+	chk.Check(res.Result.Alias, Equals, "")
+
+	body = ""
+	_, err = svc.Friend.SetAlias("u1", strings.Repeat("x", 33)).Do()
+	chk.Check(err, ErrorMatches, "account: alias must be at most 32 characters long")
+	chk.Check(body, Equals, "")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_ListAliases(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"code":0,"result":{"friends":[
+			{"user_id":"u1","display_name":"Alice","alias":"Mom"},
+			{"user_id":"u2","display_name":"Bob"}
+		]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.List().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Friends[0].Alias, Equals, "Mom")
+	chk.Check(res.Result.Friends[1].Alias, Equals, "")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_SharedDevices(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends/u1/shared-devices")
+		if r.URL.Query().Get("direction") == "sent" {
+			fmt.Fprint(w, `{"code":0,"result":{"devices":[
+				{"device_id":"d1","name":"TS-453","direction":"sent","my_role":"owner","their_role":"user"}
+			]}}`)
+			return
+		}
+		chk.Check(r.URL.RawQuery, Equals, "")
+		fmt.Fprint(w, `{"code":0,"result":{"devices":[
+			{"device_id":"d1","name":"TS-453","direction":"sent","my_role":"owner","their_role":"user"},
+			{"device_id":"d7","name":"Dad's NAS","direction":"received","my_role":"guest","their_role":"owner"}
+		]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.SharedDevices("u1").Do()
+	chk.Assert(err, IsNil)
+	d := res.Result.Devices
+	chk.Assert(d, HasLen, 2)
+	chk.Check(*d[0], Equals, SharedDevice{DeviceID: "d1", Name: "TS-453", Direction: DirectionSent, MyRole: DeviceOwner, TheirRole: DeviceUser})
+	chk.Check(d[1].Direction, Equals, DirectionReceived)
+	chk.Check(d[1].MyRole, Equals, DeviceGuest)
+	chk.Check(d[1].TheirRole, Equals, DeviceOwner)
+
+	res, err = svc.Friend.SharedDevices("u1").Direction(DirectionSent).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Devices, HasLen, 1)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Friend_SharedDevicesEmpty(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"code":0,"result":{}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.SharedDevices("u2").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Devices, NotNil)
+	chk.Check(res.Result.Devices, HasLen, 0)
+}
@@ -0,0 +1,152 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const codeAlreadyBlocked = 40910
+
+type FriendBlockResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Unfriended is set when the blocked user was a friend, and the
+		// friendship was removed.
+		Unfriended bool `json:"unfriended"`
+	} `json:"result"`
+
+	// AlreadyBlocked is set when the user was blocked already.
+	AlreadyBlocked bool `json:"-"`
+}
+
+type FriendBlockCall struct {
+	s  *Service
+	id string
+}
+
+// Block blocks a user: they can no longer invite or find the account. A
+// friendship with the user is removed. Blocking a user again succeeds.
+func (r *FriendService) Block(userID string) *FriendBlockCall {
+	c := &FriendBlockCall{s: r.s, id: userID}
+	return c
+}
+
+func (c *FriendBlockCall) Do() (*FriendBlockResponse, error) {
+	path := versioned("friends/blocked/" + url.PathEscape(c.id))
+	ret := &FriendBlockResponse{}
+	_, err := c.s.put(path, nil, ret)
+	if err != nil {
+		var e *ErrorResponse
+		if errors.As(err, &e) && e.Code == codeAlreadyBlocked {
+			return &FriendBlockResponse{Message: e.Message, Code: e.Code, AlreadyBlocked: true}, nil
+		}
+		return nil, err
+	}
+	return ret, nil
+}
+
+type FriendUnblockCall struct {
+	s  *Service
+	id string
+}
+
+// Unblock unblocks a user. The friendship removed by Block is not
+// restored.
+func (r *FriendService) Unblock(userID string) *FriendUnblockCall {
+	c := &FriendUnblockCall{s: r.s, id: userID}
+	return c
+}
+
+func (c *FriendUnblockCall) Do() error {
+	path := versioned("friends/blocked/" + url.PathEscape(c.id))
+	_, err := c.s.delete(path, nil, nil)
+	return err
+}
+
+// A BlockedUser is a user blocked by the account.
+type BlockedUser struct {
+	UserID      string    `json:"user_id"`
+	DisplayName string    `json:"display_name"`
+	AvatarURL   string    `json:"avatar_url"`
+	BlockedAt   time.Time `json:"blocked_at"`
+}
+
+type FriendBlockedListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Users         []*BlockedUser `json:"users"`
+		NextPageToken string         `json:"next_page_token"`
+	} `json:"result"`
+}
+
+type FriendBlockedService struct {
+	s *Service
+}
+
+func NewFriendBlockedService(s *Service) *FriendBlockedService {
+	rs := &FriendBlockedService{s: s}
+	return rs
+}
+
+type FriendBlockedListCall struct {
+	s      *Service
+	params url.Values
+}
+
+// List lists the users blocked by the account, most recently blocked
+// first.
+func (r *FriendBlockedService) List() *FriendBlockedListCall {
+	c := &FriendBlockedListCall{s: r.s, params: url.Values{}}
+	return c
+}
+
+// PageSize sets the maximum number of users returned per page.
+func (c *FriendBlockedListCall) PageSize(n int) *FriendBlockedListCall {
+	c.params.Set("page_size", strconv.Itoa(n))
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *FriendBlockedListCall) PageToken(token string) *FriendBlockedListCall {
+	if token == "" {
+		c.params.Del("page_token")
+		return c
+	}
+	c.params.Set("page_token", token)
+	return c
+}
+
+func (c *FriendBlockedListCall) Do() (*FriendBlockedListResponse, error) {
+	return c.doContext(context.Background())
+}
+
+func (c *FriendBlockedListCall) doContext(ctx context.Context) (*FriendBlockedListResponse, error) {
+	path := versioned("friends/blocked")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &FriendBlockedListResponse{}
+	_, err := c.s.getContext(ctx, path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Pages calls f for each page of the listing, from the page set with
+// PageToken on; see pages for the details.
+func (c *FriendBlockedListCall) Pages(ctx context.Context, f func(*FriendBlockedListResponse) error) error {
+	return pages(ctx, c.params, func() (string, error) {
+		res, err := c.doContext(ctx)
+		if err != nil {
+			return "", err
+		}
+		return res.Result.NextPageToken, f(res)
+	})
+}
@@ -0,0 +1,85 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendBlocks_Block(chk *C) {
+	friends := map[string]bool{"u1": true}
+	blocked := map[string]bool{}
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Path[len("/v1.1/friends/blocked/"):]
+		switch r.Method {
+		case "PUT":
+			if blocked[id] {
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprint(w, `{"code":40910,"message":"already blocked"}`)
+				return
+			}
+			blocked[id] = true
+			unfriended := friends[id]
+			delete(friends, id)
+			fmt.Fprintf(w, `{"code":0,"result":{"unfriended":%t}}`, unfriended)
+		case "DELETE":
+			delete(blocked, id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			chk.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Block("u1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Unfriended, Equals, true)
+	chk.Check(res.AlreadyBlocked, Equals, false)
+	chk.Check(friends["u1"], Equals, false)
+
+	res, err = svc.Friend.Block("u1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.AlreadyBlocked, Equals, true)
+	chk.Check(res.Result.Unfriended, Equals, false)
+
+	res, err = svc.Friend.Block("u2").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Unfriended, Equals, false)
+
+	chk.Check(svc.Friend.Unblock("u1").Do(), IsNil)
+	chk.Check(blocked, DeepEquals, map[string]bool{"u2": true})
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendBlocks_List(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends/blocked")
+		if r.URL.Query().Get("page_token") == "" {
+			fmt.Fprint(w, `{"code":0,"result":{"users":[
+				{"user_id":"u2","display_name":"Mallory","blocked_at":"2017-02-23T08:00:00Z"}
+			],"next_page_token":"p2"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"code":0,"result":{"users":[{"user_id":"u5","display_name":"Trudy"}]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Blocked.List().Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(res.Result.Users, HasLen, 1)
+	chk.Check(res.Result.Users[0].DisplayName, Equals, "Mallory")
+	chk.Check(res.Result.Users[0].BlockedAt.Equal(time.Date(2017, 2, 23, 8, 0, 0, 0, time.UTC)), Equals, true)
+
+	var ids []string
+	err = svc.Friend.Blocked.List().Pages(context.Background(), func(res *FriendBlockedListResponse) error {
+		for _, u := range res.Result.Users {
+			ids = append(ids, u.UserID)
+		}
+		return nil
+	})
+	chk.Assert(err, IsNil)
+	chk.Check(ids, DeepEquals, []string{"u2", "u5"})
+}
@@ -0,0 +1,51 @@
+package account
+
+import (
+	"context"
+	"io"
+	"strconv"
+)
+
+// ExportFormat is the format of an exported friend list, as a media type.
+type ExportFormat string
+
+const (
+	ExportCSV  ExportFormat = "text/csv"
+	ExportJSON ExportFormat = "application/json"
+)
+
+type FriendExportResponse struct {
+	// ContentType is the media type of the written export.
+	ContentType string
+
+	// Records is the number of exported friends, or -1 when the server
+	// did not report it.
+	Records int
+}
+
+type FriendExportCall struct {
+	s      *Service
+	ctx    context.Context
+	w      io.Writer
+	format ExportFormat
+}
+
+// Export writes the full friend list of the account to w, in the given
+// format. An error may be returned after part of the export was written.
+func (r *FriendService) Export(ctx context.Context, w io.Writer, format ExportFormat) *FriendExportCall {
+	c := &FriendExportCall{s: r.s, ctx: ctx, w: w, format: format}
+	return c
+}
+
+func (c *FriendExportCall) Do() (*FriendExportResponse, error) {
+	path := versioned("friends/export")
+	resp, err := c.s.download(c.ctx, path, string(c.format), &typedWriter{W: c.w, ContentType: string(c.format)})
+	if err != nil {
+		return nil, err
+	}
+	ret := &FriendExportResponse{ContentType: string(c.format), Records: -1}
+	if n, err := strconv.Atoi(resp.Header.Get("X-Total-Count")); err == nil {
+		ret.Records = n
+	}
+	return ret, nil
+}
@@ -0,0 +1,69 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendExport(chk *C) {
+	const csv = "user_id,display_name,email\nu1,Alice,alice@example.com\nu2,Bob,bob@example.com\n"
+	const js = `[{"user_id":"u1"},{"user_id":"u2"}]`
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends/export")
+		switch r.Header.Get("Accept") {
+		case "text/csv":
+			w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+			w.Header().Set("X-Total-Count", "2")
+			fmt.Fprint(w, csv)
+		case "application/json":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, js)
+		}
+	})
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	res, err := svc.Friend.Export(context.Background(), &buf, ExportCSV).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(buf.String(), Equals, csv)
+	chk.Check(res.ContentType, Equals, "text/csv")
+	chk.Check(res.Records, Equals, 2)
+
+	buf.Reset()
+	res, err = svc.Friend.Export(context.Background(), &buf, ExportJSON).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(buf.String(), Equals, js)
+	chk.Check(res.Records, Equals, -1)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendExport_WrongType(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, "<html>maintenance</html>")
+	})
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	_, err := svc.Friend.Export(context.Background(), &buf, ExportCSV).Do()
+	chk.Check(err, ErrorMatches, `account: unexpected Content-Type "text/html", want "text/csv"`)
+	chk.Check(buf.Len(), Equals, 0)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendExport_MidStreamFailure(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Length", "1000")
+		fmt.Fprint(w, "user_id,display_name\nu1,Alice\n")
+	})
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	_, err := svc.Friend.Export(context.Background(), &buf, ExportCSV).Do()
+	chk.Check(err, NotNil)
+	chk.Check(buf.String(), Equals, "user_id,display_name\nu1,Alice\n")
+}
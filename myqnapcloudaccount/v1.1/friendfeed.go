@@ -0,0 +1,113 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// EventType is the type of a friend feed entry. Types unknown to this
+// package are kept verbatim.
+type EventType string
+
+const (
+	// EventJoined means an invited contact joined myQNAPcloud.
+	EventJoined EventType = "joined"
+
+	// EventDeviceShared means the friend shared a device with the
+	// account; Target holds the device.
+	EventDeviceShared EventType = "device_shared"
+
+	// EventFriendAdded means the friend became friends with the account.
+	EventFriendAdded EventType = "friend_added"
+
+	// EventProfileUpdated means the friend changed their public profile.
+	EventProfileUpdated EventType = "profile_updated"
+)
+
+// A FeedEntry is an event of the friend activity feed.
+type FeedEntry struct {
+	ID    string      `json:"id"`
+	Type  EventType   `json:"type"`
+	Actor UserSummary `json:"actor"`
+
+	// Target is the object of the event, such as a device for
+	// EventDeviceShared, as received. It is nil for events without one.
+	Target    json.RawMessage `json:"target"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type FriendFeedResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Entries       []*FeedEntry `json:"entries"`
+		NextPageToken string       `json:"next_page_token"`
+	} `json:"result"`
+}
+
+type FriendFeedCall struct {
+	s      *Service
+	params url.Values
+}
+
+// Feed lists the activity of the friends of the account, most recent
+// first.
+func (r *FriendService) Feed() *FriendFeedCall {
+	c := &FriendFeedCall{s: r.s, params: url.Values{}}
+	return c
+}
+
+// Since restricts the results to entries created at or after t.
+func (c *FriendFeedCall) Since(t time.Time) *FriendFeedCall {
+	c.params.Set("since", t.UTC().Format(time.RFC3339))
+	return c
+}
+
+// PageSize sets the maximum number of entries returned per page.
+func (c *FriendFeedCall) PageSize(n int) *FriendFeedCall {
+	c.params.Set("page_size", strconv.Itoa(n))
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *FriendFeedCall) PageToken(token string) *FriendFeedCall {
+	if token == "" {
+		c.params.Del("page_token")
+		return c
+	}
+	c.params.Set("page_token", token)
+	return c
+}
+
+func (c *FriendFeedCall) Do() (*FriendFeedResponse, error) {
+	return c.doContext(context.Background())
+}
+
+func (c *FriendFeedCall) doContext(ctx context.Context) (*FriendFeedResponse, error) {
+	path := versioned("friends/feed")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &FriendFeedResponse{}
+	_, err := c.s.getContext(ctx, path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Pages calls f for each page of the listing, from the page set with
+// PageToken on; see pages for the details.
+func (c *FriendFeedCall) Pages(ctx context.Context, f func(*FriendFeedResponse) error) error {
+	return pages(ctx, c.params, func() (string, error) {
+		res, err := c.doContext(ctx)
+		if err != nil {
+			return "", err
+		}
+		return res.Result.NextPageToken, f(res)
+	})
+}
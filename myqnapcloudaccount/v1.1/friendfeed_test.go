@@ -0,0 +1,73 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendFeed(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends/feed")
+		chk.Check(r.URL.Query().Get("since"), Equals, "2017-02-20T00:00:00Z")
+		fmt.Fprint(w, `{"code":0,"result":{"entries":[
+			{"id":"e1","type":"device_shared","actor":{"user_id":"u1","display_name":"Alice"},
+			 "target":{"device_id":"d7","name":"Home TS-453"},"created_at":"2017-02-23T08:00:00Z"},
+			{"id":"e2","type":"joined","actor":{"user_id":"u2","display_name":"Bob"},"created_at":"2017-02-22T08:00:00Z"},
+			{"id":"e3","type":"album_liked","actor":{"user_id":"u3"},"target":{"album":"Taipei 2016"},"created_at":"2017-02-21T08:00:00Z"}
+		]}}`)
+	})
+	defer ts.Close()
+
+	since := time.Date(2017, 2, 20, 8, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	res, err := svc.Friend.Feed().Since(since).Do()
+	chk.Assert(err, IsNil)
+	e := res.Result.Entries
+	chk.Assert(e, HasLen, 3)
+
+	chk.Check(e[0].Type, Equals, EventDeviceShared)
+	chk.Check(e[0].Actor.DisplayName, Equals, "Alice")
+	var device struct {
+		DeviceID string `json:"device_id"`
+		Name     string `json:"name"`
+	}
+	chk.Assert(json.Unmarshal(e[0].Target, &device), IsNil)
+	chk.Check(device.Name, Equals, "Home TS-453")
+
+	chk.Check(e[1].Type, Equals, EventJoined)
+	chk.Check(e[1].Target, IsNil)
+	chk.Check(e[1].CreatedAt.Equal(time.Date(2017, 2, 22, 8, 0, 0, 0, time.UTC)), Equals, true)
+
+	chk.Check(e[2].Type, Equals, EventType("album_liked"))
+	chk.Check(string(e[2].Target), Equals, `{"album":"Taipei 2016"}`)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendFeed_Pages(chk *C) {
+	var tokens []string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("page_token")
+		tokens = append(tokens, token)
+		if token == "" {
+			fmt.Fprint(w, `{"code":0,"result":{"entries":[{"id":"e1","type":"joined"}],"next_page_token":"c2"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"code":0,"result":{"entries":[{"id":"e2","type":"friend_added"}]}}`)
+	})
+	defer ts.Close()
+
+	var ids []string
+	err := svc.Friend.Feed().Pages(context.Background(), func(res *FriendFeedResponse) error {
+		for _, e := range res.Result.Entries {
+			ids = append(ids, e.ID)
+		}
+		return nil
+	})
+	chk.Assert(err, IsNil)
+	chk.Check(ids, DeepEquals, []string{"e1", "e2"})
+	chk.Check(tokens, DeepEquals, []string{"", "c2"})
+}
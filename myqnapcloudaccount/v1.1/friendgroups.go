@@ -0,0 +1,301 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ErrNotFriend is matched by errors.Is when a user must be a friend of
+// the account, such as to join a group, and is not.
+var ErrNotFriend = errors.New("account: not a friend")
+
+const codeNotFriend = 40911
+
+const maxGroupName = 64
+
+// validateGroupName checks that name is 1 to 64 characters long and not
+// blank.
+func validateGroupName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("account: group name is empty")
+	}
+	if utf8.RuneCountInString(name) > maxGroupName {
+		return fmt.Errorf("account: group name must be at most %d characters long", maxGroupName)
+	}
+	return nil
+}
+
+// A FriendGroup is a named group of friends.
+type FriendGroup struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	MemberCount int       `json:"member_count"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type FriendGroupResponse struct {
+	Message string      `json:"message"`
+	Code    int         `json:"code"`
+	Result  FriendGroup `json:"result"`
+}
+
+type FriendGroupListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Groups []*FriendGroup `json:"groups"`
+	} `json:"result"`
+}
+
+type FriendGroupsService struct {
+	s *Service
+}
+
+func NewFriendGroupsService(s *Service) *FriendGroupsService {
+	rs := &FriendGroupsService{s: s}
+	return rs
+}
+
+type FriendGroupCreateCall struct {
+	s    *Service
+	name string
+}
+
+// Create creates an empty group.
+func (r *FriendGroupsService) Create(name string) *FriendGroupCreateCall {
+	c := &FriendGroupCreateCall{s: r.s, name: name}
+	return c
+}
+
+func (c *FriendGroupCreateCall) Do() (*FriendGroupResponse, error) {
+	if err := validateGroupName(c.name); err != nil {
+		return nil, err
+	}
+	path := versioned("friends/groups")
+	payload := struct {
+		Name string `json:"name"`
+	}{c.name}
+	ret := &FriendGroupResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type FriendGroupListCall struct {
+	s *Service
+}
+
+// List lists the groups of the account.
+func (r *FriendGroupsService) List() *FriendGroupListCall {
+	c := &FriendGroupListCall{s: r.s}
+	return c
+}
+
+func (c *FriendGroupListCall) Do() (*FriendGroupListResponse, error) {
+	path := versioned("friends/groups")
+	ret := &FriendGroupListResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type FriendGroupRenameCall struct {
+	s    *Service
+	id   string
+	name string
+}
+
+// Rename renames a group.
+func (r *FriendGroupsService) Rename(groupID, name string) *FriendGroupRenameCall {
+	c := &FriendGroupRenameCall{s: r.s, id: groupID, name: name}
+	return c
+}
+
+func (c *FriendGroupRenameCall) Do() (*FriendGroupResponse, error) {
+	if err := validateGroupName(c.name); err != nil {
+		return nil, err
+	}
+	path := versioned("friends/groups/" + url.PathEscape(c.id))
+	ret := &FriendGroupResponse{}
+	_, err := c.s.patch(path, patch{"name": c.name}, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type FriendGroupDeleteCall struct {
+	s      *Service
+	id     string
+	params url.Values
+}
+
+// Delete deletes a group. The friends in it stay friends; use
+// MoveMembersTo to put them in another group.
+func (r *FriendGroupsService) Delete(groupID string) *FriendGroupDeleteCall {
+	c := &FriendGroupDeleteCall{s: r.s, id: groupID, params: url.Values{}}
+	return c
+}
+
+// MoveMembersTo moves the members of the deleted group to another group.
+func (c *FriendGroupDeleteCall) MoveMembersTo(groupID string) *FriendGroupDeleteCall {
+	c.params.Set("move_members_to", groupID)
+	return c
+}
+
+func (c *FriendGroupDeleteCall) Do() error {
+	path := versioned("friends/groups/" + url.PathEscape(c.id))
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	_, err := c.s.delete(path, nil, nil)
+	return err
+}
+
+// A GroupMemberResult is the outcome of adding or removing one user in
+// a batch.
+type GroupMemberResult struct {
+	UserID string `json:"user_id"`
+
+	// Err is nil if the user was added or removed.
+	Err *ItemError `json:"error"`
+}
+
+type FriendGroupMembersResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Results holds an entry per user, when the API reports them.
+		Results []*GroupMemberResult `json:"results"`
+	} `json:"result"`
+}
+
+type FriendGroupAddMembersCall struct {
+	s       *Service
+	id      string
+	userIDs []string
+}
+
+// AddMembers adds friends to a group. Users who cannot be added, such as
+// users who are not friends, are reported in the per-user results rather
+// than failing the call.
+func (r *FriendGroupsService) AddMembers(groupID string, userIDs []string) *FriendGroupAddMembersCall {
+	c := &FriendGroupAddMembersCall{s: r.s, id: groupID, userIDs: userIDs}
+	return c
+}
+
+func (c *FriendGroupAddMembersCall) Do() (*FriendGroupMembersResponse, error) {
+	path := versioned("friends/groups/" + url.PathEscape(c.id) + "/members")
+	payload := struct {
+		UserIDs []string `json:"user_ids"`
+	}{c.userIDs}
+	ret := &FriendGroupMembersResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type FriendGroupRemoveMembersCall struct {
+	s       *Service
+	id      string
+	userIDs []string
+}
+
+// RemoveMembers removes users from a group. They stay friends of the
+// account.
+func (r *FriendGroupsService) RemoveMembers(groupID string, userIDs []string) *FriendGroupRemoveMembersCall {
+	c := &FriendGroupRemoveMembersCall{s: r.s, id: groupID, userIDs: userIDs}
+	return c
+}
+
+func (c *FriendGroupRemoveMembersCall) Do() (*FriendGroupMembersResponse, error) {
+	path := versioned("friends/groups/" + url.PathEscape(c.id) + "/members")
+	payload := struct {
+		UserIDs []string `json:"user_ids"`
+	}{c.userIDs}
+	ret := &FriendGroupMembersResponse{}
+	_, err := c.s.delete(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type FriendGroupMemberListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Members       []*Friend `json:"members"`
+		NextPageToken string    `json:"next_page_token"`
+	} `json:"result"`
+}
+
+type FriendGroupMembersCall struct {
+	s      *Service
+	id     string
+	params url.Values
+}
+
+// Members lists the friends in a group.
+func (r *FriendGroupsService) Members(groupID string) *FriendGroupMembersCall {
+	c := &FriendGroupMembersCall{s: r.s, id: groupID, params: url.Values{}}
+	return c
+}
+
+// PageSize sets the maximum number of members returned per page.
+func (c *FriendGroupMembersCall) PageSize(n int) *FriendGroupMembersCall {
+	c.params.Set("page_size", strconv.Itoa(n))
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *FriendGroupMembersCall) PageToken(token string) *FriendGroupMembersCall {
+	if token == "" {
+		c.params.Del("page_token")
+		return c
+	}
+	c.params.Set("page_token", token)
+	return c
+}
+
+func (c *FriendGroupMembersCall) Do() (*FriendGroupMemberListResponse, error) {
+	return c.doContext(context.Background())
+}
+
+func (c *FriendGroupMembersCall) doContext(ctx context.Context) (*FriendGroupMemberListResponse, error) {
+	path := versioned("friends/groups/" + url.PathEscape(c.id) + "/members")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &FriendGroupMemberListResponse{}
+	_, err := c.s.getContext(ctx, path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Pages calls f for each page of the listing, from the page set with
+// PageToken on; see pages for the details.
+func (c *FriendGroupMembersCall) Pages(ctx context.Context, f func(*FriendGroupMemberListResponse) error) error {
+	return pages(ctx, c.params, func() (string, error) {
+		res, err := c.doContext(ctx)
+		if err != nil {
+			return "", err
+		}
+		return res.Result.NextPageToken, f(res)
+	})
+}
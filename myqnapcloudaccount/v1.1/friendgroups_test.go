@@ -0,0 +1,184 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+// fakeGroups is a stateful fake of the friend groups endpoints.
+type fakeGroups struct {
+	chk    *C
+	groups map[string]*FriendGroup
+	next   int
+	moved  string
+}
+
+func (f *fakeGroups) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/v1.1/friends/groups"), "/")
+	switch {
+	case r.Method == "POST" && id == "":
+		var body FriendGroup
+		f.chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		f.next++
+		g := &FriendGroup{ID: fmt.Sprintf("g%d", f.next), Name: body.Name}
+		f.groups[g.ID] = g
+		json.NewEncoder(w).Encode(FriendGroupResponse{Result: *g})
+	case r.Method == "GET" && id == "":
+		ret := FriendGroupListResponse{}
+		for _, g := range f.groups {
+			ret.Result.Groups = append(ret.Result.Groups, g)
+		}
+		sort.Slice(ret.Result.Groups, func(i, j int) bool { return ret.Result.Groups[i].ID < ret.Result.Groups[j].ID })
+		json.NewEncoder(w).Encode(ret)
+	case f.groups[id] == nil:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"code":40400,"message":"group not found"}`)
+	case r.Method == "PATCH":
+		var body FriendGroup
+		f.chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		f.groups[id].Name = body.Name
+		json.NewEncoder(w).Encode(FriendGroupResponse{Result: *f.groups[id]})
+	case r.Method == "DELETE":
+		f.moved = r.URL.Query().Get("move_members_to")
+		delete(f.groups, id)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendGroups_CRUD(chk *C) {
+	fake := &fakeGroups{chk: chk, groups: map[string]*FriendGroup{}}
+	svc, ts := newFakeService(fake.ServeHTTP)
+	defer ts.Close()
+
+	family, err := svc.Friend.Groups.Create("Family").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(family.Result.Name, Equals, "Family")
+	work, err := svc.Friend.Groups.Create("Work NAS share").Do()
+	chk.Assert(err, IsNil)
+
+	res, err := svc.Friend.Groups.Rename(work.Result.ID, "Work").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Name, Equals, "Work")
+
+	list, err := svc.Friend.Groups.List().Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(list.Result.Groups, HasLen, 2)
+	chk.Check(list.Result.Groups[0].Name, Equals, "Family")
+	chk.Check(list.Result.Groups[1].Name, Equals, "Work")
+
+	chk.Check(svc.Friend.Groups.Delete(family.Result.ID).Do(), IsNil)
+	chk.Check(fake.moved, Equals, "")
+	err = svc.Friend.Groups.Delete(family.Result.ID).Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+
+	list, err = svc.Friend.Groups.List().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(list.Result.Groups, HasLen, 1)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendGroups_DeleteMoveMembers(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "DELETE")
+		chk.Check(r.URL.RawPath, Equals, "/v1.1/friends/groups/g%2F1")
+		chk.Check(r.URL.RawQuery, Equals, "move_members_to=g+2")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer ts.Close()
+
+	chk.Check(svc.Friend.Groups.Delete("g/1").MoveMembersTo("g 2").Do(), IsNil)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendGroups_NameValidation(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Error("unexpected request")
+	})
+	defer ts.Close()
+
+	_, err := svc.Friend.Groups.Create("  ").Do()
+	chk.Check(err, ErrorMatches, "account: group name is empty")
+	_, err = svc.Friend.Groups.Rename("g1", strings.Repeat("家", 65)).Do()
+	chk.Check(err, ErrorMatches, "account: group name must be at most 64 characters long")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendGroups_AddMembers(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends/groups/g1/members")
+		var body struct {
+			UserIDs []string `json:"user_ids"`
+		}
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		chk.Check(body.UserIDs, DeepEquals, []string{"u1", "u9", "u2"})
+		fmt.Fprint(w, `{"code":0,"result":{"results":[
+			{"user_id":"u1"},
+			{"user_id":"u9","error":{"code":40911,"message":"not a friend"}},
+			{"user_id":"u2"}
+		]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Groups.AddMembers("g1", []string{"u1", "u9", "u2"}).Do()
+	chk.Assert(err, IsNil)
+	r := res.Result.Results
+	chk.Assert(r, HasLen, 3)
+	chk.Check(r[0].Err, IsNil)
+	chk.Check(r[1].UserID, Equals, "u9")
+	chk.Assert(r[1].Err, NotNil)
+	chk.Check(errors.Is(r[1].Err, ErrNotFriend), Equals, true)
+	chk.Check(r[1].Err, ErrorMatches, `account: not a friend \(code 40911\)`)
+	chk.Check(r[2].Err, IsNil)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendGroups_RemoveMembers(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "DELETE")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends/groups/g1/members")
+		var body struct {
+			UserIDs []string `json:"user_ids"`
+		}
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		chk.Check(body.UserIDs, DeepEquals, []string{"u1"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Groups.RemoveMembers("g1", []string{"u1"}).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Results, HasLen, 0)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendGroups_Members(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends/groups/g1/members")
+		chk.Check(r.URL.Query().Get("page_size"), Equals, "2")
+		if r.URL.Query().Get("page_token") == "" {
+			fmt.Fprint(w, `{"code":0,"result":{"members":[{"user_id":"u1","display_name":"Alice"},{"user_id":"u2"}],"next_page_token":"p2"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"code":0,"result":{"members":[{"user_id":"u3"}]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Groups.Members("g1").PageSize(2).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Members[0].DisplayName, Equals, "Alice")
+	chk.Check(res.Result.NextPageToken, Equals, "p2")
+
+	var ids []string
+	err = svc.Friend.Groups.Members("g1").PageSize(2).Pages(context.Background(), func(res *FriendGroupMemberListResponse) error {
+		for _, m := range res.Result.Members {
+			ids = append(ids, m.UserID)
+		}
+		return nil
+	})
+	chk.Assert(err, IsNil)
+	chk.Check(ids, DeepEquals, []string{"u1", "u2", "u3"})
+}
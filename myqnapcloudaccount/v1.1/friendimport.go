@@ -0,0 +1,160 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+)
+
+// ImportFormat is the format of a contacts file.
+type ImportFormat string
+
+const (
+	ImportCSV   ImportFormat = "csv"
+	ImportVCard ImportFormat = "vcard"
+)
+
+func (f ImportFormat) filename() string {
+	if f == ImportVCard {
+		return "contacts.vcf"
+	}
+	return "contacts.csv"
+}
+
+// ImportState is the progress of a friend import job.
+type ImportState string
+
+const (
+	ImportPending ImportState = "pending"
+	ImportRunning ImportState = "running"
+	ImportDone    ImportState = "done"
+	ImportFailed  ImportState = "failed"
+)
+
+// ErrImportFailed is returned by WaitDone when an import job failed as a
+// whole.
+var ErrImportFailed = errors.New("account: friend import failed")
+
+// RowOutcome is what an import did with a row of the contacts file.
+type RowOutcome string
+
+const (
+	// RowMatched means the address belongs to a user, who was invited.
+	RowMatched RowOutcome = "matched"
+
+	// RowInvited means the address belongs to no user, and an
+	// invitation to sign up was sent.
+	RowInvited      RowOutcome = "invited"
+	RowInvalidEmail RowOutcome = "invalid_email"
+)
+
+// An ImportRow is the result of importing a row of the contacts file.
+type ImportRow struct {
+	// Row is the 1-based row number in the file.
+	Row     int        `json:"row"`
+	Email   string     `json:"email"`
+	Outcome RowOutcome `json:"outcome"`
+
+	// UserID is set for RowMatched.
+	UserID string `json:"user_id"`
+}
+
+type FriendImportResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		JobID string      `json:"job_id"`
+		State ImportState `json:"state"`
+	} `json:"result"`
+}
+
+type FriendImportCall struct {
+	s      *Service
+	ctx    context.Context
+	r      io.Reader
+	format ImportFormat
+}
+
+// Import uploads a contacts file and invites the addresses it holds. The
+// file is processed asynchronously; see ImportStatus.
+func (r *FriendService) Import(ctx context.Context, rd io.Reader, format ImportFormat) *FriendImportCall {
+	c := &FriendImportCall{s: r.s, ctx: ctx, r: rd, format: format}
+	return c
+}
+
+func (c *FriendImportCall) Do() (*FriendImportResponse, error) {
+	path := versioned("friends/imports")
+	fields := map[string]string{"format": string(c.format)}
+	ret := &FriendImportResponse{}
+	_, err := c.s.upload(c.ctx, path, fields, "file", c.format.filename(), c.r, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type FriendImportStatusResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		JobID string      `json:"job_id"`
+		State ImportState `json:"state"`
+
+		// Rows holds the results of the rows processed so far.
+		Rows []*ImportRow `json:"rows"`
+	} `json:"result"`
+}
+
+type FriendImportStatusCall struct {
+	s   *Service
+	ctx context.Context
+	id  string
+}
+
+// ImportStatus retrieves the progress of an import job.
+func (r *FriendService) ImportStatus(jobID string) *FriendImportStatusCall {
+	c := &FriendImportStatusCall{s: r.s, ctx: context.Background(), id: jobID}
+	return c
+}
+
+// Context sets the context of the call.
+func (c *FriendImportStatusCall) Context(ctx context.Context) *FriendImportStatusCall {
+	c.ctx = ctx
+	return c
+}
+
+func (c *FriendImportStatusCall) Do() (*FriendImportStatusResponse, error) {
+	path := versioned("friends/imports/" + url.PathEscape(c.id))
+	ret := &FriendImportStatusResponse{}
+	_, err := c.s.getContext(c.ctx, path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// WaitDone polls the job until it is done, and returns its final status.
+// It returns ErrImportFailed if the job failed.
+func (c *FriendImportStatusCall) WaitDone(ctx context.Context, opts ...WaitOption) (*FriendImportStatusResponse, error) {
+	c.ctx = ctx
+	var ret *FriendImportStatusResponse
+	err := WaitFor(ctx, func() (bool, error) {
+		res, err := c.Do()
+		if err != nil {
+			return false, err
+		}
+		ret = res
+		switch res.Result.State {
+		case ImportDone:
+			return true, nil
+		case ImportFailed:
+			return false, ErrImportFailed
+		}
+		return false, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
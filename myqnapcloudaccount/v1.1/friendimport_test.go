@@ -0,0 +1,70 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendImport(chk *C) {
+	const csv = "email\nalice@example.com\nnew@example.com\nnot-an-email\n"
+	polls := 0
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/v1.1/friends/imports":
+			chk.Check(r.Header.Get("Content-Type"), Matches, "multipart/form-data; boundary=.*")
+			chk.Check(r.FormValue("format"), Equals, "csv")
+			f, h, err := r.FormFile("file")
+			chk.Assert(err, IsNil)
+			chk.Check(h.Filename, Equals, "contacts.csv")
+			b, _ := io.ReadAll(f)
+			chk.Check(string(b), Equals, csv)
+			fmt.Fprint(w, `{"code":0,"result":{"job_id":"job-1","state":"pending"}}`)
+		case r.Method == "GET" && r.URL.Path == "/v1.1/friends/imports/job-1":
+			polls++
+			if polls < 3 {
+				fmt.Fprint(w, `{"code":0,"result":{"job_id":"job-1","state":"running","rows":[
+					{"row":2,"email":"alice@example.com","outcome":"matched","user_id":"u1"}]}}`)
+				return
+			}
+			fmt.Fprint(w, `{"code":0,"result":{"job_id":"job-1","state":"done","rows":[
+				{"row":2,"email":"alice@example.com","outcome":"matched","user_id":"u1"},
+				{"row":3,"email":"new@example.com","outcome":"invited"},
+				{"row":4,"email":"not-an-email","outcome":"invalid_email"}]}}`)
+		default:
+			chk.Errorf("unexpected %s %s", r.Method, r.URL)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Import(context.Background(), strings.NewReader(csv), ImportCSV).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.JobID, Equals, "job-1")
+	chk.Check(res.Result.State, Equals, ImportPending)
+
+	st, err := svc.Friend.ImportStatus("job-1").WaitDone(context.Background(), WaitInterval(time.Millisecond))
+	chk.Assert(err, IsNil)
+	chk.Check(polls, Equals, 3)
+	chk.Check(st.Result.State, Equals, ImportDone)
+	rows := st.Result.Rows
+	chk.Assert(rows, HasLen, 3)
+	chk.Check(*rows[0], Equals, ImportRow{Row: 2, Email: "alice@example.com", Outcome: RowMatched, UserID: "u1"})
+	chk.Check(rows[1].Outcome, Equals, RowInvited)
+	chk.Check(rows[2].Outcome, Equals, RowInvalidEmail)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendImport_Failed(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"code":0,"result":{"job_id":"job-2","state":"failed"}}`)
+	})
+	defer ts.Close()
+
+	_, err := svc.Friend.ImportStatus("job-2").WaitDone(context.Background(), WaitInterval(time.Millisecond))
+	chk.Check(errors.Is(err, ErrImportFailed), Equals, true)
+}
@@ -0,0 +1,315 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ErrResendCooldown is matched by errors.Is when an invitation is resent
+// too soon after the previous sending. See ResendCooldown.
+var ErrResendCooldown = errors.New("account: invitation resent too soon")
+
+const (
+	codeInvitationHandled  = 40909
+	codeInvitationAccepted = 40912
+	codeResendCooldown     = 42901
+)
+
+// ResendCooldown returns how long to wait before the invitation that
+// failed with ErrResendCooldown can be resent.
+func ResendCooldown(err error) (time.Duration, bool) {
+	var e *ErrorResponse
+	if !errors.As(err, &e) || e.Code != codeResendCooldown {
+		return 0, false
+	}
+	var details struct {
+		RetryAfterSeconds *int `json:"retry_after_seconds"`
+	}
+	if len(e.Details) > 0 && json.Unmarshal(e.Details, &details) == nil && details.RetryAfterSeconds != nil {
+		return time.Duration(*details.RetryAfterSeconds) * time.Second, true
+	}
+	if e.RetryAfter > 0 {
+		return e.RetryAfter, true
+	}
+	return 0, false
+}
+
+// terminal reports whether an invitation in state s can no longer change.
+func (s InvitationState) terminal() bool {
+	return s == InvitationAccepted || s == InvitationDeclined || s == InvitationCancelled
+}
+
+// Direction selects sent or received invitations.
+type Direction string
+
+const (
+	DirectionReceived Direction = "received"
+	DirectionSent     Direction = "sent"
+)
+
+// An InvitationUser is the other party of a friend invitation: the
+// inviter of a received invitation, the invitee of a sent one.
+type InvitationUser struct {
+	UserID      string `json:"user_id"`
+	DisplayName string `json:"display_name"`
+	Email       string `json:"email"`
+
+	// AvatarURL is empty when the user has no avatar.
+	AvatarURL string `json:"avatar_url"`
+}
+
+// An Invitation is a pending friend invitation.
+type Invitation struct {
+	ID   string         `json:"id"`
+	User InvitationUser `json:"user"`
+
+	// Message is the personal note of the inviter, empty if none.
+	Message   string          `json:"message"`
+	State     InvitationState `json:"state"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type FriendInvitationsService struct {
+	s *Service
+}
+
+func NewFriendInvitationsService(s *Service) *FriendInvitationsService {
+	rs := &FriendInvitationsService{s: s}
+	return rs
+}
+
+type FriendInvitationListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Invitations   []*Invitation `json:"invitations"`
+		NextPageToken string        `json:"next_page_token"`
+	} `json:"result"`
+}
+
+type FriendInvitationListCall struct {
+	s      *Service
+	params url.Values
+}
+
+// List lists the pending invitations of the account, received ones unless
+// set otherwise with Direction.
+func (r *FriendInvitationsService) List() *FriendInvitationListCall {
+	c := &FriendInvitationListCall{s: r.s, params: url.Values{}}
+	c.params.Set("direction", string(DirectionReceived))
+	return c
+}
+
+// Direction selects sent or received invitations.
+func (c *FriendInvitationListCall) Direction(d Direction) *FriendInvitationListCall {
+	c.params.Set("direction", string(d))
+	return c
+}
+
+// PageSize sets the maximum number of invitations returned per page.
+func (c *FriendInvitationListCall) PageSize(n int) *FriendInvitationListCall {
+	c.params.Set("page_size", strconv.Itoa(n))
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *FriendInvitationListCall) PageToken(token string) *FriendInvitationListCall {
+	if token == "" {
+		c.params.Del("page_token")
+		return c
+	}
+	c.params.Set("page_token", token)
+	return c
+}
+
+func (c *FriendInvitationListCall) Do() (*FriendInvitationListResponse, error) {
+	return c.doContext(context.Background())
+}
+
+func (c *FriendInvitationListCall) doContext(ctx context.Context) (*FriendInvitationListResponse, error) {
+	path := versioned("friends/invitations") + "?" + c.params.Encode()
+	ret := &FriendInvitationListResponse{}
+	_, err := c.s.getContext(ctx, path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Pages calls f for each page of the listing, from the page set with
+// PageToken on; see pages for the details.
+func (c *FriendInvitationListCall) Pages(ctx context.Context, f func(*FriendInvitationListResponse) error) error {
+	return pages(ctx, c.params, func() (string, error) {
+		res, err := c.doContext(ctx)
+		if err != nil {
+			return "", err
+		}
+		return res.Result.NextPageToken, f(res)
+	})
+}
+
+type FriendInvitationAcceptResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  Friend `json:"result"`
+
+	// AlreadyHandled is set, and Result left empty, when the invitation
+	// was accepted or declined already.
+	AlreadyHandled bool `json:"-"`
+}
+
+type FriendInvitationAcceptCall struct {
+	s  *Service
+	id string
+}
+
+// Accept accepts an incoming friend invitation. Unknown IDs yield an
+// error matching ErrNotFound.
+func (r *FriendInvitationsService) Accept(invitationID string) *FriendInvitationAcceptCall {
+	c := &FriendInvitationAcceptCall{s: r.s, id: invitationID}
+	return c
+}
+
+func (c *FriendInvitationAcceptCall) Do() (*FriendInvitationAcceptResponse, error) {
+	path := versioned("friends/invitations/" + url.PathEscape(c.id) + "/accept")
+	ret := &FriendInvitationAcceptResponse{}
+	_, err := c.s.post(path, nil, ret)
+	if err != nil {
+		var e *ErrorResponse
+		if errors.As(err, &e) && e.Code == codeInvitationHandled {
+			return &FriendInvitationAcceptResponse{Message: e.Message, Code: e.Code, AlreadyHandled: true}, nil
+		}
+		return nil, err
+	}
+	return ret, nil
+}
+
+type FriendInvitationDeclineResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+
+	// AlreadyHandled is set when the invitation was accepted or declined
+	// already.
+	AlreadyHandled bool `json:"-"`
+}
+
+type FriendInvitationDeclineCall struct {
+	s  *Service
+	id string
+}
+
+// Decline declines an incoming friend invitation. Unknown IDs yield an
+// error matching ErrNotFound.
+func (r *FriendInvitationsService) Decline(invitationID string) *FriendInvitationDeclineCall {
+	c := &FriendInvitationDeclineCall{s: r.s, id: invitationID}
+	return c
+}
+
+func (c *FriendInvitationDeclineCall) Do() (*FriendInvitationDeclineResponse, error) {
+	path := versioned("friends/invitations/" + url.PathEscape(c.id) + "/decline")
+	ret := &FriendInvitationDeclineResponse{}
+	_, err := c.s.post(path, nil, ret)
+	if err != nil {
+		var e *ErrorResponse
+		if errors.As(err, &e) && e.Code == codeInvitationHandled {
+			return &FriendInvitationDeclineResponse{Message: e.Message, Code: e.Code, AlreadyHandled: true}, nil
+		}
+		return nil, err
+	}
+	return ret, nil
+}
+
+type FriendInvitationCancelResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+
+	// AlreadyCancelled is set when the invitation was cancelled already.
+	AlreadyCancelled bool `json:"-"`
+
+	// AlreadyAccepted is set when the invitation could not be cancelled
+	// because it was accepted already: the users are now friends.
+	AlreadyAccepted bool `json:"-"`
+}
+
+type FriendInvitationCancelCall struct {
+	s  *Service
+	id string
+}
+
+// Cancel retracts a sent friend invitation. Cancelling an invitation
+// again succeeds; an invitation accepted meanwhile is reported with
+// AlreadyAccepted.
+func (r *FriendInvitationsService) Cancel(invitationID string) *FriendInvitationCancelCall {
+	c := &FriendInvitationCancelCall{s: r.s, id: invitationID}
+	return c
+}
+
+func (c *FriendInvitationCancelCall) Do() (*FriendInvitationCancelResponse, error) {
+	path := versioned("friends/invitations/" + url.PathEscape(c.id))
+	ret := &FriendInvitationCancelResponse{}
+	_, err := c.s.delete(path, nil, ret)
+	if err != nil {
+		var e *ErrorResponse
+		if !errors.As(err, &e) {
+			return nil, err
+		}
+		switch e.Code {
+		case codeInvitationHandled:
+			return &FriendInvitationCancelResponse{Message: e.Message, Code: e.Code, AlreadyCancelled: true}, nil
+		case codeInvitationAccepted:
+			return &FriendInvitationCancelResponse{Message: e.Message, Code: e.Code, AlreadyAccepted: true}, nil
+		}
+		return nil, err
+	}
+	return ret, nil
+}
+
+type FriendInvitationResendResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// NextResendAt is the earliest time the invitation can be resent
+		// again.
+		NextResendAt time.Time `json:"next_resend_at"`
+	} `json:"result"`
+}
+
+type FriendInvitationResendCall struct {
+	s     *Service
+	id    string
+	state InvitationState
+}
+
+// Resend sends the email of a pending sent invitation again. When called
+// too soon, the returned error matches ErrResendCooldown; see
+// ResendCooldown for the time left to wait.
+func (r *FriendInvitationsService) Resend(invitationID string) *FriendInvitationResendCall {
+	c := &FriendInvitationResendCall{s: r.s, id: invitationID}
+	return c
+}
+
+// State sets the state of the invitation as last listed, so that
+// invitations that are no longer pending are refused without a request.
+func (c *FriendInvitationResendCall) State(s InvitationState) *FriendInvitationResendCall {
+	c.state = s
+	return c
+}
+
+func (c *FriendInvitationResendCall) Do() (*FriendInvitationResendResponse, error) {
+	if c.state.terminal() {
+		return nil, fmt.Errorf("account: cannot resend %s invitation", c.state)
+	}
+	path := versioned("friends/invitations/" + url.PathEscape(c.id) + "/resend")
+	ret := &FriendInvitationResendResponse{}
+	_, err := c.s.post(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,219 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendInvitations_List(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends/invitations")
+		switch r.URL.Query().Get("direction") {
+		case "received":
+			fmt.Fprint(w, `{"code":0,"result":{"invitations":[
+				{"id":"inv-1","user":{"user_id":"u7","display_name":"Grace","avatar_url":"https://cdn.example.com/g.png"},
+				 "message":"We met at Computex","state":"pending","created_at":"2017-02-20T09:00:00Z"},
+				{"id":"inv-2","user":{"user_id":"u8","display_name":"Heidi"},"state":"pending","created_at":"2017-02-21T09:00:00Z"}
+			]}}`)
+		case "sent":
+			fmt.Fprint(w, `{"code":0,"result":{"invitations":[
+				{"id":"inv-3","user":{"email":"ivan@example.com"},"state":"pending","created_at":"2017-02-22T09:00:00Z"}
+			]}}`)
+		default:
+			chk.Errorf("unexpected direction %q", r.URL.Query().Get("direction"))
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Invitations.List().Do()
+	chk.Assert(err, IsNil)
+	inv := res.Result.Invitations
+	chk.Assert(inv, HasLen, 2)
+	chk.Check(inv[0].ID, Equals, "inv-1")
+	chk.Check(inv[0].User.AvatarURL, Equals, "https://cdn.example.com/g.png")
+	chk.Check(inv[0].Message, Equals, "We met at Computex")
+	chk.Check(inv[0].CreatedAt.Equal(time.Date(2017, 2, 20, 9, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(inv[1].User.DisplayName, Equals, "Heidi")
+	chk.Check(inv[1].User.AvatarURL, Equals, "")
+	chk.Check(inv[1].Message, Equals, "")
+
+	res, err = svc.Friend.Invitations.List().Direction(DirectionSent).Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(res.Result.Invitations, HasLen, 1)
+	chk.Check(res.Result.Invitations[0].User.Email, Equals, "ivan@example.com")
+	chk.Check(res.Result.Invitations[0].State, Equals, InvitationPending)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendInvitations_ListPages(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Query().Get("page_size"), Equals, "1")
+		if r.URL.Query().Get("page_token") == "" {
+			fmt.Fprint(w, `{"code":0,"result":{"invitations":[{"id":"inv-1"}],"next_page_token":"p2"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"code":0,"result":{"invitations":[{"id":"inv-2"}]}}`)
+	})
+	defer ts.Close()
+
+	var ids []string
+	err := svc.Friend.Invitations.List().PageSize(1).Pages(context.Background(), func(res *FriendInvitationListResponse) error {
+		for _, inv := range res.Result.Invitations {
+			ids = append(ids, inv.ID)
+		}
+		return nil
+	})
+	chk.Assert(err, IsNil)
+	chk.Check(ids, DeepEquals, []string{"inv-1", "inv-2"})
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendInvitations_Accept(chk *C) {
+	handled := map[string]bool{}
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.RawPath, Equals, "/v1.1/friends/invitations/inv%2F1/accept")
+		if handled[r.URL.Path] {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"code":40909,"message":"invitation already handled"}`)
+			return
+		}
+		handled[r.URL.Path] = true
+		fmt.Fprint(w, `{"code":0,"result":{"user_id":"u9","display_name":"Erin","friended_at":"2017-02-23T08:00:00Z"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Invitations.Accept("inv/1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.AlreadyHandled, Equals, false)
+	chk.Check(res.Result.UserID, Equals, "u9")
+	chk.Check(res.Result.DisplayName, Equals, "Erin")
+
+	res, err = svc.Friend.Invitations.Accept("inv/1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.AlreadyHandled, Equals, true)
+	chk.Check(res.Result.UserID, Equals, "")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendInvitations_Decline(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends/invitations/inv-2/decline")
+		fmt.Fprint(w, `{"code":0}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Invitations.Decline("inv-2").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.AlreadyHandled, Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendInvitations_Unknown(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"code":40400,"message":"invitation not found"}`)
+	})
+	defer ts.Close()
+
+	_, err := svc.Friend.Invitations.Accept("nope").Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+	_, err = svc.Friend.Invitations.Decline("nope").Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendInvitations_Cancel(chk *C) {
+	state := map[string]string{"inv-1": "pending", "inv-2": "accepted"}
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "DELETE")
+		id := r.URL.Path[len("/v1.1/friends/invitations/"):]
+		switch state[id] {
+		case "pending":
+			state[id] = "cancelled"
+			w.WriteHeader(http.StatusNoContent)
+		case "cancelled":
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"code":40909,"message":"invitation already handled"}`)
+		case "accepted":
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"code":40912,"message":"invitation already accepted"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":40400,"message":"invitation not found"}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Invitations.Cancel("inv-1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.AlreadyCancelled, Equals, false)
+	chk.Check(res.AlreadyAccepted, Equals, false)
+
+	res, err = svc.Friend.Invitations.Cancel("inv-1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.AlreadyCancelled, Equals, true)
+
+	res, err = svc.Friend.Invitations.Cancel("inv-2").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.AlreadyAccepted, Equals, true)
+	chk.Check(res.AlreadyCancelled, Equals, false)
+
+	_, err = svc.Friend.Invitations.Cancel("inv-3").Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendInvitations_Resend(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		switch r.URL.Path {
+		case "/v1.1/friends/invitations/inv-1/resend":
+			fmt.Fprint(w, `{"code":0,"result":{"next_resend_at":"2017-02-24T08:00:00Z"}}`)
+		case "/v1.1/friends/invitations/inv-2/resend":
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"code":42901,"message":"resent too soon","details":{"retry_after_seconds":3600}}`)
+		case "/v1.1/friends/invitations/inv-3/resend":
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"code":42901,"message":"resent too soon"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":40400,"message":"invitation not found"}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Invitations.Resend("inv-1").State(InvitationPending).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.NextResendAt.Equal(time.Date(2017, 2, 24, 8, 0, 0, 0, time.UTC)), Equals, true)
+
+	_, err = svc.Friend.Invitations.Resend("inv-2").Do()
+	chk.Check(errors.Is(err, ErrResendCooldown), Equals, true)
+	d, ok := ResendCooldown(err)
+	chk.Check(ok, Equals, true)
+	chk.Check(d, Equals, time.Hour)
+
+	_, err = svc.Friend.Invitations.Resend("inv-3").Do()
+	d, ok = ResendCooldown(err)
+	chk.Check(ok, Equals, true)
+	chk.Check(d, Equals, 2*time.Minute)
+
+	_, err = svc.Friend.Invitations.Resend("inv-4").Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+	_, ok = ResendCooldown(err)
+	chk.Check(ok, Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendInvitations_ResendTerminal(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Error("unexpected request")
+	})
+	defer ts.Close()
+
+	for _, st := range []InvitationState{InvitationAccepted, InvitationDeclined, InvitationCancelled} {
+		_, err := svc.Friend.Invitations.Resend("inv-1").State(st).Do()
+		chk.Check(err, ErrorMatches, "account: cannot resend "+string(st)+" invitation")
+	}
+}
@@ -0,0 +1,152 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// A UserSummary is the public summary of a user.
+type UserSummary struct {
+	UserID      string `json:"user_id"`
+	DisplayName string `json:"display_name"`
+	AvatarURL   string `json:"avatar_url"`
+}
+
+// ReasonKind is the kind of a suggestion reason.
+type ReasonKind string
+
+const (
+	ReasonMutualFriends ReasonKind = "mutual_friends"
+	ReasonSharedDevice  ReasonKind = "shared_device"
+)
+
+// A SuggestionReason tells why a user is suggested. The fields set depend
+// on Kind.
+type SuggestionReason struct {
+	Kind ReasonKind
+
+	// MutualFriends is the number of friends in common, for
+	// ReasonMutualFriends.
+	MutualFriends int
+
+	// DeviceName is the name of a device shared with the user, for
+	// ReasonSharedDevice.
+	DeviceName string
+
+	// Raw holds the reason as received, so that kinds unknown to this
+	// package can still be inspected.
+	Raw json.RawMessage
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *SuggestionReason) UnmarshalJSON(b []byte) error {
+	var v struct {
+		Type       ReasonKind `json:"type"`
+		Count      int        `json:"count"`
+		DeviceName string     `json:"device_name"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*r = SuggestionReason{Kind: v.Type, Raw: append(json.RawMessage(nil), b...)}
+	switch v.Type {
+	case ReasonMutualFriends:
+		r.MutualFriends = v.Count
+	case ReasonSharedDevice:
+		r.DeviceName = v.DeviceName
+	}
+	return nil
+}
+
+// A Suggestion is a user the account may know.
+type Suggestion struct {
+	User   UserSummary      `json:"user"`
+	Reason SuggestionReason `json:"reason"`
+}
+
+type FriendSuggestionListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Suggestions   []*Suggestion `json:"suggestions"`
+		NextPageToken string        `json:"next_page_token"`
+	} `json:"result"`
+}
+
+type FriendSuggestionListCall struct {
+	s      *Service
+	params url.Values
+}
+
+// Suggestions lists people the account may know, based on mutual friends
+// and shared devices.
+func (r *FriendService) Suggestions() *FriendSuggestionListCall {
+	c := &FriendSuggestionListCall{s: r.s, params: url.Values{}}
+	return c
+}
+
+// PageSize sets the maximum number of suggestions returned per page.
+func (c *FriendSuggestionListCall) PageSize(n int) *FriendSuggestionListCall {
+	c.params.Set("page_size", strconv.Itoa(n))
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *FriendSuggestionListCall) PageToken(token string) *FriendSuggestionListCall {
+	if token == "" {
+		c.params.Del("page_token")
+		return c
+	}
+	c.params.Set("page_token", token)
+	return c
+}
+
+func (c *FriendSuggestionListCall) Do() (*FriendSuggestionListResponse, error) {
+	return c.doContext(context.Background())
+}
+
+func (c *FriendSuggestionListCall) doContext(ctx context.Context) (*FriendSuggestionListResponse, error) {
+	path := versioned("friends/suggestions")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &FriendSuggestionListResponse{}
+	_, err := c.s.getContext(ctx, path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Pages calls f for each page of the listing, from the page set with
+// PageToken on; see pages for the details.
+func (c *FriendSuggestionListCall) Pages(ctx context.Context, f func(*FriendSuggestionListResponse) error) error {
+	return pages(ctx, c.params, func() (string, error) {
+		res, err := c.doContext(ctx)
+		if err != nil {
+			return "", err
+		}
+		return res.Result.NextPageToken, f(res)
+	})
+}
+
+type FriendSuggestionDismissCall struct {
+	s  *Service
+	id string
+}
+
+// Dismiss removes a user from the suggestions listed by Suggestions, for
+// good.
+func (r *FriendService) Dismiss(userID string) *FriendSuggestionDismissCall {
+	c := &FriendSuggestionDismissCall{s: r.s, id: userID}
+	return c
+}
+
+func (c *FriendSuggestionDismissCall) Do() error {
+	path := versioned("friends/suggestions/" + url.PathEscape(c.id) + "/dismiss")
+	_, err := c.s.post(path, nil, nil)
+	return err
+}
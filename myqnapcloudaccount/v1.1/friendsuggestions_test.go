@@ -0,0 +1,70 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendSuggestions_List(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/friends/suggestions")
+		fmt.Fprint(w, `{"code":0,"result":{"suggestions":[
+			{"user":{"user_id":"u11","display_name":"Ken"},"reason":{"type":"mutual_friends","count":4}},
+			{"user":{"user_id":"u12","display_name":"Lin","avatar_url":"https://cdn.example.com/l.png"},
+			 "reason":{"type":"shared_device","device_name":"Office TS-873"}},
+			{"user":{"user_id":"u13"},"reason":{"type":"same_organization","organization":"QNAP"}}
+		]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Friend.Suggestions().Do()
+	chk.Assert(err, IsNil)
+	sg := res.Result.Suggestions
+	chk.Assert(sg, HasLen, 3)
+	chk.Check(sg[0].User.DisplayName, Equals, "Ken")
+	chk.Check(sg[0].Reason.Kind, Equals, ReasonMutualFriends)
+	chk.Check(sg[0].Reason.MutualFriends, Equals, 4)
+	chk.Check(sg[1].Reason.Kind, Equals, ReasonSharedDevice)
+	chk.Check(sg[1].Reason.DeviceName, Equals, "Office TS-873")
+	chk.Check(sg[1].Reason.MutualFriends, Equals, 0)
+	chk.Check(sg[2].Reason.Kind, Equals, ReasonKind("same_organization"))
+	chk.Check(string(sg[2].Reason.Raw), Equals, `{"type":"same_organization","organization":"QNAP"}`)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendSuggestions_Pages(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page_token") == "" {
+			fmt.Fprint(w, `{"code":0,"result":{"suggestions":[{"user":{"user_id":"u11"}}],"next_page_token":"p2"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"code":0,"result":{"suggestions":[{"user":{"user_id":"u12"}}]}}`)
+	})
+	defer ts.Close()
+
+	var ids []string
+	err := svc.Friend.Suggestions().Pages(context.Background(), func(res *FriendSuggestionListResponse) error {
+		for _, sg := range res.Result.Suggestions {
+			ids = append(ids, sg.User.UserID)
+		}
+		return nil
+	})
+	chk.Assert(err, IsNil)
+	chk.Check(ids, DeepEquals, []string{"u11", "u12"})
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_FriendSuggestions_Dismiss(chk *C) {
+	var dismissed []string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		dismissed = append(dismissed, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer ts.Close()
+
+	chk.Assert(svc.Friend.Dismiss("u11").Do(), IsNil)
+	chk.Check(dismissed, DeepEquals, []string{"/v1.1/friends/suggestions/u11/dismiss"})
+}
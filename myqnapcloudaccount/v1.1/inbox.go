@@ -0,0 +1,248 @@
+package account
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// codeNoEntry is the item error code of an inbox entry that does not
+// exist, which Delete takes for an entry deleted already.
+const codeNoEntry = 40413
+
+// An InboxEntry is a notification in the in-app inbox of the account.
+type InboxEntry struct {
+	ID       string               `json:"id"`
+	Category NotificationCategory `json:"category"`
+
+	// Title and Body are in the language selected with Language.
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	Read      bool      `json:"read"`
+
+	// ActionURL is the page the notification links to, empty if none.
+	ActionURL string `json:"action_url"`
+}
+
+type InboxService struct {
+	s *Service
+}
+
+func NewInboxService(s *Service) *InboxService {
+	rs := &InboxService{s: s}
+	return rs
+}
+
+type InboxListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Entries       []*InboxEntry `json:"entries"`
+		NextPageToken string        `json:"next_page_token"`
+
+		// UnreadCount is the number of unread entries in the whole inbox,
+		// whatever the filters.
+		UnreadCount int `json:"unread_count"`
+	} `json:"result"`
+}
+
+type InboxListCall struct {
+	s      *Service
+	params url.Values
+	header http.Header
+}
+
+// List lists the notifications in the inbox, newest first.
+func (r *InboxService) List() *InboxListCall {
+	c := &InboxListCall{s: r.s, params: url.Values{}, header: http.Header{}}
+	return c
+}
+
+// UnreadOnly restricts the listing to the unread notifications.
+func (c *InboxListCall) UnreadOnly() *InboxListCall {
+	c.params.Set("unread", "true")
+	return c
+}
+
+// Language sets the Accept-Language header selecting the language of the
+// titles and bodies, e.g. "zh-TW".
+func (c *InboxListCall) Language(lang string) *InboxListCall {
+	c.header.Set("Accept-Language", lang)
+	return c
+}
+
+// PageSize sets the maximum number of notifications returned per page.
+func (c *InboxListCall) PageSize(n int) *InboxListCall {
+	c.params.Set("page_size", strconv.Itoa(n))
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *InboxListCall) PageToken(token string) *InboxListCall {
+	if token == "" {
+		c.params.Del("page_token")
+		return c
+	}
+	c.params.Set("page_token", token)
+	return c
+}
+
+func (c *InboxListCall) Do() (*InboxListResponse, error) {
+	return c.doContext(context.Background())
+}
+
+func (c *InboxListCall) doContext(ctx context.Context) (*InboxListResponse, error) {
+	path := versioned("me/inbox")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	req, err := c.s.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range c.header {
+		req.Header[k] = v
+	}
+	ret := &InboxListResponse{}
+	_, err = c.s.do(req.WithContext(ctx), ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Pages calls f for each page of the listing, from the page set with
+// PageToken on; see pages for the details.
+func (c *InboxListCall) Pages(ctx context.Context, f func(*InboxListResponse) error) error {
+	return pages(ctx, c.params, func() (string, error) {
+		res, err := c.doContext(ctx)
+		if err != nil {
+			return "", err
+		}
+		return res.Result.NextPageToken, f(res)
+	})
+}
+
+// An InboxResult is the outcome of marking or deleting one entry of a
+// batch.
+type InboxResult struct {
+	ID string `json:"id"`
+
+	// Changed is false if the entry was read or deleted already, which is
+	// not an error.
+	Changed bool `json:"changed"`
+
+	// Err is nil if the entry is now read or deleted.
+	Err *ItemError `json:"error"`
+}
+
+type InboxBatchResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Results holds an entry per ID, in the order of the input.
+		Results []*InboxResult `json:"results"`
+	} `json:"result"`
+}
+
+type InboxMarkReadCall struct {
+	s   *Service
+	ids []string
+}
+
+// MarkRead marks entries as read. Entries read already are reported
+// unchanged, and entries that cannot be marked are reported in the
+// per-entry results rather than failing the call.
+func (r *InboxService) MarkRead(ids ...string) *InboxMarkReadCall {
+	c := &InboxMarkReadCall{s: r.s, ids: ids}
+	return c
+}
+
+func (c *InboxMarkReadCall) Do() (*InboxBatchResponse, error) {
+	path := versioned("me/inbox/read")
+	payload := struct {
+		IDs []string `json:"ids"`
+	}{c.ids}
+	ret := &InboxBatchResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type InboxMarkAllReadResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Marked is the number of entries that were unread.
+		Marked int `json:"marked"`
+	} `json:"result"`
+}
+
+type InboxMarkAllReadCall struct {
+	s      *Service
+	params url.Values
+}
+
+// MarkAllRead marks every entry of the inbox as read.
+func (r *InboxService) MarkAllRead() *InboxMarkAllReadCall {
+	c := &InboxMarkAllReadCall{s: r.s, params: url.Values{}}
+	return c
+}
+
+// Before restricts the call to entries created before t, so that entries
+// arrived since the inbox was displayed stay unread.
+func (c *InboxMarkAllReadCall) Before(t time.Time) *InboxMarkAllReadCall {
+	c.params.Set("before", t.UTC().Format(time.RFC3339))
+	return c
+}
+
+func (c *InboxMarkAllReadCall) Do() (*InboxMarkAllReadResponse, error) {
+	path := versioned("me/inbox/read-all")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &InboxMarkAllReadResponse{}
+	_, err := c.s.post(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type InboxDeleteCall struct {
+	s   *Service
+	ids []string
+}
+
+// Delete deletes entries from the inbox. Entries deleted already are
+// reported unchanged, and entries that cannot be deleted are reported in
+// the per-entry results rather than failing the call.
+func (r *InboxService) Delete(ids ...string) *InboxDeleteCall {
+	c := &InboxDeleteCall{s: r.s, ids: ids}
+	return c
+}
+
+func (c *InboxDeleteCall) Do() (*InboxBatchResponse, error) {
+	path := versioned("me/inbox")
+	payload := struct {
+		IDs []string `json:"ids"`
+	}{c.ids}
+	ret := &InboxBatchResponse{}
+	_, err := c.s.delete(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range ret.Result.Results {
+		if r.Err != nil && r.Err.Code == codeNoEntry {
+			r.Changed = false
+			r.Err = nil
+		}
+	}
+	return ret, nil
+}
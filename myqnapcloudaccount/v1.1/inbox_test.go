@@ -0,0 +1,142 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Inbox_List(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/inbox")
+		chk.Check(r.URL.Query().Get("unread"), Equals, "true")
+		chk.Check(r.Header.Get("Accept-Language"), Equals, "zh-TW")
+		fmt.Fprint(w, `{"code":0,"result":{"unread_count":7,"next_page_token":"c2","entries":[
+			{"id":"n1","category":"security_alerts","title":"新的登入","body":"從台北登入","created_at":"2017-02-23T08:00:00Z",
+			 "action_url":"https://account.myqnapcloud.com/security"},
+			{"id":"n2","category":"friend_requests","title":"好友邀請","body":"Alice 想成為你的好友","created_at":"2017-02-22T08:00:00Z"},
+			{"id":"n3","category":"product_news","title":"QTS 4.3","body":"新版本","created_at":"2017-02-21T08:00:00Z"},
+			{"id":"n4","category":"device_offline","title":"裝置離線","body":"Home 已離線","created_at":"2017-02-20T08:00:00Z"}
+		]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Inbox.List().UnreadOnly().Language("zh-TW").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.UnreadCount, Equals, 7)
+	chk.Check(res.Result.NextPageToken, Equals, "c2")
+	e := res.Result.Entries
+	chk.Assert(e, HasLen, 4)
+	chk.Check(e[0].Category, Equals, NotifySecurityAlerts)
+	chk.Check(e[0].Title, Equals, "新的登入")
+	chk.Check(e[0].ActionURL, Equals, "https://account.myqnapcloud.com/security")
+	chk.Check(e[0].CreatedAt.Equal(time.Date(2017, 2, 23, 8, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(e[1].Category, Equals, NotifyFriendRequests)
+	chk.Check(e[1].ActionURL, Equals, "")
+	chk.Check(e[2].Category, Equals, NotifyProductNews)
+	chk.Check(e[3].Category, Equals, NotificationCategory("device_offline"))
+	chk.Check(e[3].Body, Equals, "Home 已離線")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Inbox_Pages(chk *C) {
+	var tokens []string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("page_token")
+		tokens = append(tokens, token)
+		if token == "" {
+			fmt.Fprint(w, `{"code":0,"result":{"unread_count":2,"entries":[{"id":"n1"}],"next_page_token":"c2"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"code":0,"result":{"unread_count":2,"entries":[{"id":"n2"}]}}`)
+	})
+	defer ts.Close()
+
+	var ids []string
+	err := svc.Me.Inbox.List().Pages(context.Background(), func(res *InboxListResponse) error {
+		for _, e := range res.Result.Entries {
+			ids = append(ids, e.ID)
+		}
+		return nil
+	})
+	chk.Assert(err, IsNil)
+	chk.Check(tokens, DeepEquals, []string{"", "c2"})
+	chk.Check(ids, DeepEquals, []string{"n1", "n2"})
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Inbox_MarkRead(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/inbox/read")
+		body, _ := io.ReadAll(r.Body)
+		chk.Check(string(body), Equals, `{"ids":["n1","n2","n9"]}`+"\n")
+		fmt.Fprint(w, `{"code":0,"result":{"results":[
+			{"id":"n1","changed":true},
+			{"id":"n2","changed":false},
+			{"id":"n9","error":{"code":40413,"message":"no such entry"}}
+		]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Inbox.MarkRead("n1", "n2", "n9").Do()
+	chk.Assert(err, IsNil)
+	r := res.Result.Results
+	chk.Assert(r, HasLen, 3)
+	chk.Check(r[0].Changed, Equals, true)
+	chk.Check(r[0].Err, IsNil)
+	chk.Check(r[1].Changed, Equals, false)
+	chk.Check(r[1].Err, IsNil)
+	chk.Assert(r[2].Err, NotNil)
+	chk.Check(r[2].Err.Code, Equals, codeNoEntry)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Inbox_MarkAllRead(chk *C) {
+	var queries []string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/inbox/read-all")
+		queries = append(queries, r.URL.RawQuery)
+		fmt.Fprint(w, `{"code":0,"result":{"marked":3}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Inbox.MarkAllRead().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Marked, Equals, 3)
+
+	taipei := time.FixedZone("CST", 8*60*60)
+	_, err = svc.Me.Inbox.MarkAllRead().Before(time.Date(2017, 2, 23, 16, 30, 0, 0, taipei)).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(queries, DeepEquals, []string{"", "before=2017-02-23T08%3A30%3A00Z"})
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Inbox_Delete(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "DELETE")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/inbox")
+		body, _ := io.ReadAll(r.Body)
+		chk.Check(string(body), Equals, `{"ids":["n1","n2","n3"]}`+"\n")
+		fmt.Fprint(w, `{"code":0,"result":{"results":[
+			{"id":"n1","changed":true},
+			{"id":"n2","error":{"code":40413,"message":"no such entry"}},
+			{"id":"n3","error":{"code":50000,"message":"internal error"}}
+		]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Inbox.Delete("n1", "n2", "n3").Do()
+	chk.Assert(err, IsNil)
+	r := res.Result.Results
+	chk.Assert(r, HasLen, 3)
+	chk.Check(r[0].Changed, Equals, true)
+	chk.Check(r[0].Err, IsNil)
+	// Deleting an entry deleted already succeeds unchanged.
+	chk.Check(r[1].Changed, Equals, false)
+	chk.Check(r[1].Err, IsNil)
+	chk.Assert(r[2].Err, NotNil)
+	chk.Check(r[2].Err.Code, Equals, 50000)
+}
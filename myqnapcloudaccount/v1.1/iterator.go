@@ -0,0 +1,60 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"net/url"
+)
+
+// Done is returned by iterators when there are no more items.
+var Done = errors.New("account: no more items in iterator")
+
+// PageInfo describes the position of an iterator in a paged listing.
+//
+// To resume an interrupted iteration, pass NextPageToken to the PageToken
+// option of a new listing call: the remaining entries of the current page
+// will be skipped, so save it once a page has been fully processed.
+type PageInfo struct {
+	// Token of the page currently being read, empty for the first page.
+	Token string
+
+	// Token of the page following the current one, empty on the last page.
+	NextPageToken string
+
+	// Fetched reports whether at least one page has been retrieved.
+	Fetched bool
+}
+
+// pages implements the Pages method of the listing calls, which call f
+// for each page of a listing. It calls fetch for each page, starting at
+// the page set with PageToken in params if any, and stops at the last
+// page or when fetch returns an error, which pages then returns. fetch
+// returns the token of the following page, empty on the last one. The
+// page_token of params is restored afterwards, so the call can be reused.
+func pages(ctx context.Context, params url.Values, fetch func() (string, error)) error {
+	start := params.Get("page_token")
+	defer setPageToken(params, start)
+	token := start
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		setPageToken(params, token)
+		next, err := fetch()
+		if err != nil {
+			return err
+		}
+		if next == "" {
+			return nil
+		}
+		token = next
+	}
+}
+
+func setPageToken(params url.Values, token string) {
+	if token == "" {
+		params.Del("page_token")
+		return
+	}
+	params.Set("page_token", token)
+}
@@ -0,0 +1,108 @@
+package account
+
+import (
+	"sync"
+	"time"
+)
+
+// LanguagesTTL is how long the list fetched by User.Languages is reused
+// before being fetched again.
+var LanguagesTTL = 24 * time.Hour
+
+// A Language is a language supported for the account.
+type Language struct {
+	// Code is the language tag, such as "zh-TW", accepted by the Language
+	// setter of MeUpdateCall.
+	Code        string `json:"code"`
+	NativeName  string `json:"native_name"`
+	EnglishName string `json:"english_name"`
+}
+
+type LanguageListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Languages []*Language `json:"languages"`
+	} `json:"result"`
+}
+
+// languageCache holds the last fetched list of supported languages.
+type languageCache struct {
+	mu        sync.Mutex
+	list      []*Language
+	fetchedAt time.Time
+}
+
+// get returns the cached list, even if expired, or nil if none was
+// fetched.
+func (lc *languageCache) get() []*Language {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	return lc.list
+}
+
+// fresh returns the cached list if it is younger than LanguagesTTL.
+func (lc *languageCache) fresh() ([]*Language, bool) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	if lc.list == nil || now().Sub(lc.fetchedAt) >= LanguagesTTL {
+		return nil, false
+	}
+	return lc.list, true
+}
+
+func (lc *languageCache) set(list []*Language) {
+	if list == nil {
+		list = []*Language{}
+	}
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.list = list
+	lc.fetchedAt = now()
+}
+
+func supportsLanguage(list []*Language, code string) bool {
+	for _, l := range list {
+		if l.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+type UserLanguagesCall struct {
+	r       *UserService
+	refresh bool
+}
+
+// Languages lists the languages an account can be set to. The list is
+// cached for LanguagesTTL; once fetched, the Language setter of
+// MeUpdateCall validates against it.
+func (r *UserService) Languages() *UserLanguagesCall {
+	c := &UserLanguagesCall{r: r}
+	return c
+}
+
+// Refresh fetches the list even if a cached one is still fresh.
+func (c *UserLanguagesCall) Refresh() *UserLanguagesCall {
+	c.refresh = true
+	return c
+}
+
+func (c *UserLanguagesCall) Do() (*LanguageListResponse, error) {
+	if !c.refresh {
+		if list, ok := c.r.languages.fresh(); ok {
+			ret := &LanguageListResponse{}
+			ret.Result.Languages = list
+			return ret, nil
+		}
+	}
+	path := versioned("languages")
+	ret := &LanguageListResponse{}
+	_, err := c.r.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	c.r.languages.set(ret.Result.Languages)
+	return ret, nil
+}
@@ -0,0 +1,74 @@
+package account
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+const languagesFixture = `{"code":0,"result":{"languages":[
+	{"code":"en-US","native_name":"English","english_name":"English"},
+	{"code":"zh-TW","native_name":"繁體中文","english_name":"Traditional Chinese"},
+	{"code":"ja-JP","native_name":"日本語","english_name":"Japanese"}
+]}}`
+
+func (s *MySuite) Test_Myqnapcloud_Account_Languages_Cache(chk *C) {
+	defer func(f func() time.Time) { now = f }(now)
+	t := time.Date(2017, 2, 23, 8, 0, 0, 0, time.UTC)
+	now = func() time.Time { return t }
+
+	requests := 0
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/languages")
+		fmt.Fprint(w, languagesFixture)
+	})
+	defer ts.Close()
+
+	res, err := svc.User.Languages().Do()
+	chk.Assert(err, IsNil)
+	l := res.Result.Languages
+	chk.Assert(l, HasLen, 3)
+	chk.Check(*l[1], Equals, Language{Code: "zh-TW", NativeName: "繁體中文", EnglishName: "Traditional Chinese"})
+	chk.Check(requests, Equals, 1)
+
+	t = t.Add(LanguagesTTL - time.Second)
+	res, err = svc.User.Languages().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Languages, HasLen, 3)
+	chk.Check(requests, Equals, 1)
+
+	_, err = svc.User.Languages().Refresh().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(requests, Equals, 2)
+
+	t = t.Add(LanguagesTTL)
+	_, err = svc.User.Languages().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(requests, Equals, 3)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Languages_UpdateValidation(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1.1/languages" {
+			fmt.Fprint(w, languagesFixture)
+			return
+		}
+		fmt.Fprint(w, `{"code":0,"result":{"language":"tlh"}}`)
+	})
+	defer ts.Close()
+
+	// Before the list is fetched, any language is sent as is.
+	_, err := svc.Me.Update().Language("tlh").Do()
+	chk.Check(err, IsNil)
+
+	_, err = svc.User.Languages().Do()
+	chk.Assert(err, IsNil)
+	_, err = svc.Me.Update().Language("tlh").Do()
+	chk.Check(err, ErrorMatches, `account: unsupported language "tlh"`)
+	_, err = svc.Me.Update().Language("ja-JP").Do()
+	chk.Check(err, IsNil)
+}
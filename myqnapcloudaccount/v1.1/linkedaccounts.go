@@ -0,0 +1,87 @@
+package account
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+// ErrWouldLockOut is matched by errors.Is when unlinking the only sign-in
+// credential of an account without password.
+var ErrWouldLockOut = errors.New("account: unlinking would lock the account out")
+
+const codeWouldLockOut = 40904
+
+// Provider identifies a social sign-in provider. Providers unknown to this
+// package are kept verbatim.
+type Provider string
+
+const (
+	ProviderGoogle   Provider = "google"
+	ProviderApple    Provider = "apple"
+	ProviderFacebook Provider = "facebook"
+)
+
+// A LinkedAccount is a social sign-in linked to the account.
+type LinkedAccount struct {
+	Provider Provider `json:"provider"`
+
+	// DisplayName is the name of the account at the provider.
+	DisplayName string    `json:"display_name"`
+	LinkedAt    time.Time `json:"linked_at"`
+}
+
+type LinkedAccountListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		LinkedAccounts []*LinkedAccount `json:"linked_accounts"`
+	} `json:"result"`
+}
+
+type LinkedAccountsService struct {
+	s *Service
+}
+
+func NewLinkedAccountsService(s *Service) *LinkedAccountsService {
+	rs := &LinkedAccountsService{s: s}
+	return rs
+}
+
+type LinkedAccountListCall struct {
+	s *Service
+}
+
+// List lists the social sign-ins linked to the account.
+func (r *LinkedAccountsService) List() *LinkedAccountListCall {
+	c := &LinkedAccountListCall{s: r.s}
+	return c
+}
+
+func (c *LinkedAccountListCall) Do() (*LinkedAccountListResponse, error) {
+	path := versioned("me/linked-accounts")
+	ret := &LinkedAccountListResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type LinkedAccountUnlinkCall struct {
+	s        *Service
+	provider Provider
+}
+
+// Unlink removes the social sign-in of provider. It fails with an error
+// matching ErrWouldLockOut if that is the last way to sign in.
+func (r *LinkedAccountsService) Unlink(provider Provider) *LinkedAccountUnlinkCall {
+	c := &LinkedAccountUnlinkCall{s: r.s, provider: provider}
+	return c
+}
+
+func (c *LinkedAccountUnlinkCall) Do() error {
+	path := versioned("me/linked-accounts/" + url.PathEscape(string(c.provider)))
+	_, err := c.s.delete(path, nil, nil)
+	return err
+}
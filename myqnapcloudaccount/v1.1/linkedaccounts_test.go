@@ -0,0 +1,56 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_LinkedAccounts_List(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/linked-accounts")
+		fmt.Fprint(w, `{"code":0,"result":{"linked_accounts":[
+			{"provider":"google","display_name":"alice@gmail.com","linked_at":"2016-05-01T00:00:00Z"},
+			{"provider":"line","display_name":"Alice","linked_at":"2017-01-01T00:00:00Z"}
+		]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.LinkedAccounts.List().Do()
+	chk.Assert(err, IsNil)
+	la := res.Result.LinkedAccounts
+	chk.Assert(la, HasLen, 2)
+	chk.Check(la[0].Provider, Equals, ProviderGoogle)
+	chk.Check(la[0].DisplayName, Equals, "alice@gmail.com")
+	chk.Check(la[0].LinkedAt.Equal(time.Date(2016, 5, 1, 0, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(la[1].Provider, Equals, Provider("line"))
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_LinkedAccounts_Unlink(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "DELETE")
+		switch r.URL.Path {
+		case "/v1.1/me/linked-accounts/facebook":
+			w.WriteHeader(http.StatusNoContent)
+		case "/v1.1/me/linked-accounts/apple":
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"code":40904,"message":"unlinking would lock out account"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer ts.Close()
+
+	err := svc.Me.LinkedAccounts.Unlink(ProviderFacebook).Do()
+	chk.Check(err, IsNil)
+
+	err = svc.Me.LinkedAccounts.Unlink(ProviderApple).Do()
+	chk.Check(errors.Is(err, ErrWouldLockOut), Equals, true)
+
+	err = svc.Me.LinkedAccounts.Unlink(ProviderGoogle).Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+	chk.Check(errors.Is(err, ErrWouldLockOut), Equals, false)
+}
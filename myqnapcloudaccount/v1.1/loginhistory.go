@@ -0,0 +1,99 @@
+package account
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// A GeoLocation is the position resolved from an IP address. Any field
+// may be empty when the resolution was partial.
+type GeoLocation struct {
+	City        string       `json:"city"`
+	Region      string       `json:"region"`
+	Country     string       `json:"country"`
+	CountryCode string       `json:"country_code"`
+	Coordinates *Coordinates `json:"coordinates"`
+}
+
+// A Login is a sign-in attempt on the account.
+type Login struct {
+	ID      string `json:"id"`
+	Success bool   `json:"success"`
+	IP      string `json:"ip"`
+
+	// ASN and ASOrg identify the network the IP address belongs to.
+	ASN   int    `json:"asn"`
+	ASOrg string `json:"as_org"`
+
+	// Location is nil when the IP address could not be located.
+	Location *GeoLocation `json:"location"`
+	Device   string       `json:"device"`
+
+	// NewDevice is set on the first sign-in from a device.
+	NewDevice  bool      `json:"new_device"`
+	Suspicious bool      `json:"suspicious"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type LoginHistoryListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Logins        []*Login `json:"logins"`
+		NextPageToken string   `json:"next_page_token"`
+	} `json:"result"`
+}
+
+type LoginHistoryService struct {
+	s *Service
+}
+
+func NewLoginHistoryService(s *Service) *LoginHistoryService {
+	rs := &LoginHistoryService{s: s}
+	return rs
+}
+
+type LoginHistoryListCall struct {
+	s      *Service
+	params url.Values
+}
+
+// List lists the sign-in attempts on the account, most recent first.
+func (r *LoginHistoryService) List() *LoginHistoryListCall {
+	c := &LoginHistoryListCall{s: r.s, params: url.Values{}}
+	return c
+}
+
+// PageSize sets the maximum number of logins returned per page.
+func (c *LoginHistoryListCall) PageSize(n int) *LoginHistoryListCall {
+	c.params.Set("page_size", strconv.Itoa(n))
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *LoginHistoryListCall) PageToken(token string) *LoginHistoryListCall {
+	c.params.Set("page_token", token)
+	return c
+}
+
+// Suspicious restricts the results to logins flagged suspicious, or to
+// the ones not flagged.
+func (c *LoginHistoryListCall) Suspicious(b bool) *LoginHistoryListCall {
+	c.params.Set("suspicious", strconv.FormatBool(b))
+	return c
+}
+
+func (c *LoginHistoryListCall) Do() (*LoginHistoryListResponse, error) {
+	path := versioned("me/login-history")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &LoginHistoryListResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,70 @@
+package account
+
+import (
+	"fmt"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_LoginHistory_List(chk *C) {
+	var query string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/login-history")
+		query = r.URL.RawQuery
+		fmt.Fprint(w, `{"code":0,"result":{"logins":[
+			{"id":"l1","success":true,"ip":"203.0.113.7","asn":3462,"as_org":"HiNet","new_device":true,
+			 "location":{"city":"Taipei","region":"Taipei City","country":"Taiwan","country_code":"TW",
+			             "coordinates":{"latitude":25.0478,"longitude":121.5319}},
+			 "created_at":"2017-02-22T01:00:00Z"},
+			{"id":"l2","success":false,"ip":"198.51.100.2","suspicious":true,
+			 "location":{"country":"Netherlands","country_code":"NL"},"created_at":"2017-02-21T01:00:00Z"},
+			{"id":"l3","success":true,"ip":"10.0.0.2","location":null,"created_at":"2017-02-20T01:00:00Z"},
+			{"id":"l4","success":true,"ip":"fd00::1","created_at":"2017-02-19T01:00:00Z"}
+		],"next_page_token":"h2"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.LoginHistory.List().PageSize(4).Suspicious(false).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(query, Equals, "page_size=4&suspicious=false")
+	chk.Check(res.Result.NextPageToken, Equals, "h2")
+	l := res.Result.Logins
+	chk.Assert(l, HasLen, 4)
+
+	// full geolocation
+	chk.Check(l[0].ASN, Equals, 3462)
+	chk.Check(l[0].ASOrg, Equals, "HiNet")
+	chk.Check(l[0].NewDevice, Equals, true)
+	chk.Assert(l[0].Location, NotNil)
+	chk.Check(l[0].Location.City, Equals, "Taipei")
+	chk.Check(l[0].Location.CountryCode, Equals, "TW")
+	chk.Assert(l[0].Location.Coordinates, NotNil)
+	chk.Check(l[0].Location.Coordinates.Latitude, Equals, 25.0478)
+
+	// partial geolocation
+	chk.Check(l[1].Suspicious, Equals, true)
+	chk.Assert(l[1].Location, NotNil)
+	chk.Check(l[1].Location.City, Equals, "")
+	chk.Check(l[1].Location.CountryCode, Equals, "NL")
+	chk.Check(l[1].Location.Coordinates, IsNil)
+
+	// no geolocation
+	chk.Check(l[2].Location, IsNil)
+	chk.Check(l[3].Location, IsNil)
+	chk.Check(l[3].ASN, Equals, 0)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_LoginHistory_Suspicious(chk *C) {
+	var query string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		fmt.Fprint(w, `{"code":0,"result":{"logins":[]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.LoginHistory.List().Suspicious(true).PageToken("h2").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(query, Equals, "page_token=h2&suspicious=true")
+	chk.Check(res.Result.Logins, HasLen, 0)
+}
@@ -0,0 +1,127 @@
+package account
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ErrMergeBlocked is matched by errors.Is when the secondary account
+// still has devices registered and cannot be merged. See
+// MergeBlockingDevices.
+var ErrMergeBlocked = errors.New("account: secondary account has devices")
+
+const codeMergeBlocked = 40906
+
+// MergeBlockingDevices returns the number of devices registered to the
+// secondary account when a merge failed with ErrMergeBlocked.
+func MergeBlockingDevices(err error) (int, bool) {
+	var e *ErrorResponse
+	if !errors.As(err, &e) || e.Code != codeMergeBlocked || len(e.Details) == 0 {
+		return 0, false
+	}
+	var details struct {
+		DeviceCount *int `json:"device_count"`
+	}
+	if json.Unmarshal(e.Details, &details) != nil || details.DeviceCount == nil {
+		return 0, false
+	}
+	return *details.DeviceCount, true
+}
+
+// MergeState is the progress of an account merge.
+type MergeState string
+
+const (
+	// MergePending means the confirmation email sent to the secondary
+	// account was not acted on yet.
+	MergePending   MergeState = "pending"
+	MergeConfirmed MergeState = "confirmed"
+	MergeCompleted MergeState = "completed"
+)
+
+type MergeResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		State          MergeState `json:"state"`
+		SecondaryEmail string     `json:"secondary_email"`
+		RequestedAt    time.Time  `json:"requested_at"`
+		CompletedAt    time.Time  `json:"completed_at"`
+	} `json:"result"`
+}
+
+type MergeService struct {
+	s *Service
+}
+
+func NewMergeService(s *Service) *MergeService {
+	rs := &MergeService{s: s}
+	return rs
+}
+
+type MergeRequestCall struct {
+	s     *Service
+	email string
+}
+
+// Request starts merging the account registered with secondaryEmail into
+// this one. A confirmation email is sent to the secondary account; the
+// merge proceeds once it is confirmed.
+func (r *MergeService) Request(secondaryEmail string) *MergeRequestCall {
+	c := &MergeRequestCall{s: r.s, email: secondaryEmail}
+	return c
+}
+
+func (c *MergeRequestCall) Do() (*MergeResponse, error) {
+	if err := validateEmail(c.email); err != nil {
+		return nil, err
+	}
+	path := versioned("me/merge")
+	payload := struct {
+		SecondaryEmail string `json:"secondary_email"`
+	}{c.email}
+	ret := &MergeResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type MergeStatusCall struct {
+	s *Service
+}
+
+// Status retrieves the current merge request. The returned error matches
+// ErrNotFound when there is none.
+func (r *MergeService) Status() *MergeStatusCall {
+	c := &MergeStatusCall{s: r.s}
+	return c
+}
+
+func (c *MergeStatusCall) Do() (*MergeResponse, error) {
+	path := versioned("me/merge")
+	ret := &MergeResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type MergeCancelCall struct {
+	s *Service
+}
+
+// Cancel cancels the current merge request, unless it has completed.
+func (r *MergeService) Cancel() *MergeCancelCall {
+	c := &MergeCancelCall{s: r.s}
+	return c
+}
+
+func (c *MergeCancelCall) Do() error {
+	path := versioned("me/merge")
+	_, err := c.s.delete(path, nil, nil)
+	return err
+}
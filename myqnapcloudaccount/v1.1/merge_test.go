@@ -0,0 +1,69 @@
+package account
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Merge_Flow(chk *C) {
+	state := ""
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/merge")
+		switch r.Method {
+		case "POST":
+			var body map[string]string
+			chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+			chk.Check(body, DeepEquals, map[string]string{"secondary_email": "old@example.com"})
+			state = "pending"
+		case "DELETE":
+			state = ""
+			fmt.Fprint(w, `{"code":0}`)
+			return
+		}
+		if state == "" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":40400,"message":"no merge request"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"code":0,"result":{"state":%q,"secondary_email":"old@example.com","requested_at":"2017-02-23T08:00:00Z"}}`, state)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Merge.Request("old@example.com").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.State, Equals, MergePending)
+	chk.Check(res.Result.SecondaryEmail, Equals, "old@example.com")
+
+	state = "confirmed"
+	res, err = svc.Me.Merge.Status().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.State, Equals, MergeConfirmed)
+
+	chk.Assert(svc.Me.Merge.Cancel().Do(), IsNil)
+	_, err = svc.Me.Merge.Status().Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+
+	_, err = svc.Me.Merge.Request("not an email").Do()
+	chk.Check(err, ErrorMatches, "account: invalid email address .*")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Merge_Blocked(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"code":40906,"message":"secondary account has devices","details":{"device_count":3}}`)
+	})
+	defer ts.Close()
+
+	_, err := svc.Me.Merge.Request("old@example.com").Do()
+	chk.Check(errors.Is(err, ErrMergeBlocked), Equals, true)
+	n, ok := MergeBlockingDevices(err)
+	chk.Check(ok, Equals, true)
+	chk.Check(n, Equals, 3)
+
+	_, ok = MergeBlockingDevices(ErrNotFound)
+	chk.Check(ok, Equals, false)
+}
@@ -0,0 +1,91 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+)
+
+type MutualFriendsResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Friends is empty, never nil, when there are no mutual friends.
+		Friends       []*Friend `json:"friends"`
+		NextPageToken string    `json:"next_page_token"`
+
+		// TotalCount is the number of mutual friends over all pages.
+		TotalCount int `json:"total_count"`
+
+		// Hidden is set when the privacy settings of the user hide their
+		// friends. TotalCount is then zero.
+		Hidden bool `json:"hidden"`
+	} `json:"result"`
+}
+
+type UserMutualFriendsCall struct {
+	s      *Service
+	userID string
+	params url.Values
+}
+
+// MutualFriends lists the friends the account has in common with a user.
+func (r *UserService) MutualFriends(userID string) *UserMutualFriendsCall {
+	c := &UserMutualFriendsCall{s: r.s, userID: userID, params: url.Values{}}
+	return c
+}
+
+// PageSize sets the maximum number of friends returned per page.
+func (c *UserMutualFriendsCall) PageSize(n int) *UserMutualFriendsCall {
+	c.params.Set("page_size", strconv.Itoa(n))
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *UserMutualFriendsCall) PageToken(token string) *UserMutualFriendsCall {
+	if token == "" {
+		c.params.Del("page_token")
+		return c
+	}
+	c.params.Set("page_token", token)
+	return c
+}
+
+func (c *UserMutualFriendsCall) Do() (*MutualFriendsResponse, error) {
+	return c.doContext(context.Background())
+}
+
+func (c *UserMutualFriendsCall) doContext(ctx context.Context) (*MutualFriendsResponse, error) {
+	path := versioned("users/" + url.PathEscape(c.userID) + "/mutual-friends")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &MutualFriendsResponse{}
+	_, err := c.s.getContext(ctx, path, ret)
+	if errors.Is(err, ErrForbidden) {
+		ret = &MutualFriendsResponse{}
+		ret.Result.Hidden = true
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if ret.Result.Friends == nil {
+		ret.Result.Friends = []*Friend{}
+	}
+	return ret, nil
+}
+
+// Pages calls f for each page of the listing, from the page set with
+// PageToken on; see pages for the details.
+func (c *UserMutualFriendsCall) Pages(ctx context.Context, f func(*MutualFriendsResponse) error) error {
+	return pages(ctx, c.params, func() (string, error) {
+		res, err := c.doContext(ctx)
+		if err != nil {
+			return "", err
+		}
+		return res.Result.NextPageToken, f(res)
+	})
+}
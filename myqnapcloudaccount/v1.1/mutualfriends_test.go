@@ -0,0 +1,70 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_MutualFriends(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/users/u42/mutual-friends")
+		chk.Check(r.URL.Query().Get("page_size"), Equals, "2")
+		fmt.Fprint(w, `{"code":0,"result":{"friends":[
+			{"user_id":"u1","display_name":"Alice"},
+			{"user_id":"u2","display_name":"Bob"}
+		],"total_count":3,"next_page_token":"c2"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.User.MutualFriends("u42").PageSize(2).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Hidden, Equals, false)
+	chk.Check(res.Result.TotalCount, Equals, 3)
+	chk.Check(res.Result.NextPageToken, Equals, "c2")
+	chk.Assert(res.Result.Friends, HasLen, 2)
+	chk.Check(res.Result.Friends[1].DisplayName, Equals, "Bob")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_MutualFriends_Pages(chk *C) {
+	var tokens []string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("page_token")
+		tokens = append(tokens, token)
+		if token == "" {
+			fmt.Fprint(w, `{"code":0,"result":{"friends":[{"user_id":"u1"}],"total_count":2,"next_page_token":"c2"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"code":0,"result":{"friends":[{"user_id":"u2"}],"total_count":2}}`)
+	})
+	defer ts.Close()
+
+	var ids []string
+	err := svc.User.MutualFriends("u42").Pages(context.Background(), func(res *MutualFriendsResponse) error {
+		chk.Check(res.Result.TotalCount, Equals, 2)
+		for _, f := range res.Result.Friends {
+			ids = append(ids, f.UserID)
+		}
+		return nil
+	})
+	chk.Assert(err, IsNil)
+	chk.Check(tokens, DeepEquals, []string{"", "c2"})
+	chk.Check(ids, DeepEquals, []string{"u1", "u2"})
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_MutualFriends_Hidden(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"code":40300,"message":"friends hidden"}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.User.MutualFriends("u42").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Hidden, Equals, true)
+	chk.Check(res.Result.TotalCount, Equals, 0)
+	chk.Check(res.Result.Friends, HasLen, 0)
+}
@@ -0,0 +1,150 @@
+package account
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// ErrUnknownTopic is matched by errors.Is when a newsletter topic does not
+// exist. See UnknownTopic.
+var ErrUnknownTopic = errors.New("account: unknown newsletter topic")
+
+const codeUnknownTopic = 40003
+
+// TopicID identifies a newsletter topic. Topics unknown to this package
+// are kept verbatim.
+type TopicID string
+
+const (
+	TopicProductUpdates     TopicID = "product_updates"
+	TopicSecurityAdvisories TopicID = "security_advisories"
+	TopicPromotions         TopicID = "promotions"
+	TopicBetaInvites        TopicID = "beta_invites"
+)
+
+// UnknownTopic returns the topic the API rejected in err, when it reported
+// it.
+func UnknownTopic(err error) (TopicID, bool) {
+	var e *ErrorResponse
+	if !errors.As(err, &e) || e.Code != codeUnknownTopic || len(e.Details) == 0 {
+		return "", false
+	}
+	var details struct {
+		TopicID TopicID `json:"topic_id"`
+	}
+	if json.Unmarshal(e.Details, &details) != nil || details.TopicID == "" {
+		return "", false
+	}
+	return details.TopicID, true
+}
+
+// A Topic is a newsletter topic and whether the account opted in to it.
+type Topic struct {
+	ID TopicID `json:"id"`
+
+	// Title is the display name in the requested language.
+	Title      string `json:"title"`
+	Subscribed bool   `json:"subscribed"`
+}
+
+type NewsletterTopicsResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Topics []*Topic `json:"topics"`
+	} `json:"result"`
+}
+
+type NewsletterService struct {
+	s *Service
+}
+
+func NewNewsletterService(s *Service) *NewsletterService {
+	rs := &NewsletterService{s: s}
+	return rs
+}
+
+type NewsletterTopicsCall struct {
+	s      *Service
+	header http.Header
+}
+
+// Topics lists the newsletter topics with their opt-in state.
+func (r *NewsletterService) Topics() *NewsletterTopicsCall {
+	c := &NewsletterTopicsCall{s: r.s, header: http.Header{}}
+	return c
+}
+
+// Language sets the Accept-Language header selecting the language of the
+// titles, e.g. "zh-TW".
+func (c *NewsletterTopicsCall) Language(lang string) *NewsletterTopicsCall {
+	c.header.Set("Accept-Language", lang)
+	return c
+}
+
+func (c *NewsletterTopicsCall) Do() (*NewsletterTopicsResponse, error) {
+	path := versioned("me/newsletter/topics")
+	ret := &NewsletterTopicsResponse{}
+	_, err := c.s.getWithHeader(path, c.header, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type NewsletterSetTopicsCall struct {
+	s       *Service
+	topics  map[TopicID]bool
+	current []*Topic
+}
+
+// SetTopics opts in to or out of the given topics. Topics absent from the
+// map stay unchanged.
+func (r *NewsletterService) SetTopics(topics map[TopicID]bool) *NewsletterSetTopicsCall {
+	c := &NewsletterSetTopicsCall{s: r.s, topics: topics}
+	return c
+}
+
+// Current sets the topics as last listed by Topics, so that only the
+// entries that change are sent. When nothing changes no request is made
+// and Do returns current.
+func (c *NewsletterSetTopicsCall) Current(topics []*Topic) *NewsletterSetTopicsCall {
+	c.current = topics
+	return c
+}
+
+func (c *NewsletterSetTopicsCall) changed() map[TopicID]bool {
+	if c.current == nil {
+		return c.topics
+	}
+	state := make(map[TopicID]bool, len(c.current))
+	for _, t := range c.current {
+		state[t.ID] = t.Subscribed
+	}
+	changed := map[TopicID]bool{}
+	for id, on := range c.topics {
+		if cur, ok := state[id]; !ok || cur != on {
+			changed[id] = on
+		}
+	}
+	return changed
+}
+
+func (c *NewsletterSetTopicsCall) Do() (*NewsletterTopicsResponse, error) {
+	ret := &NewsletterTopicsResponse{}
+	changed := c.changed()
+	if len(changed) == 0 {
+		ret.Result.Topics = c.current
+		return ret, nil
+	}
+	path := versioned("me/newsletter/topics")
+	payload := struct {
+		Topics map[TopicID]bool `json:"topics"`
+	}{changed}
+	_, err := c.s.patch(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,85 @@
+package account
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+const newsletterTopicsFixture = `{"code":0,"result":{"topics":[
+	{"id":"product_updates","title":"產品更新","subscribed":true},
+	{"id":"security_advisories","title":"安全性公告","subscribed":true},
+	{"id":"promotions","title":"優惠活動","subscribed":false},
+	{"id":"beta_invites","title":"搶先體驗","subscribed":false}
+]}}`
+
+func (s *MySuite) Test_Myqnapcloud_Account_Newsletter_Topics(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/newsletter/topics")
+		chk.Check(r.Header.Get("Accept-Language"), Equals, "zh-TW")
+		fmt.Fprint(w, newsletterTopicsFixture)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Newsletter.Topics().Language("zh-TW").Do()
+	chk.Assert(err, IsNil)
+	t := res.Result.Topics
+	chk.Assert(t, HasLen, 4)
+	chk.Check(t[0].ID, Equals, TopicProductUpdates)
+	chk.Check(t[0].Title, Equals, "產品更新")
+	chk.Check(t[0].Subscribed, Equals, true)
+	chk.Check(t[2].ID, Equals, TopicPromotions)
+	chk.Check(t[2].Subscribed, Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Newsletter_SetTopicsChangedOnly(chk *C) {
+	var current NewsletterTopicsResponse
+	chk.Assert(json.Unmarshal([]byte(newsletterTopicsFixture), &current), IsNil)
+
+	requests := 0
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		chk.Check(r.Method, Equals, "PATCH")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/newsletter/topics")
+		var body map[string]map[string]bool
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		chk.Check(body, DeepEquals, map[string]map[string]bool{"topics": {"promotions": true}})
+		fmt.Fprint(w, `{"code":0,"result":{"topics":[{"id":"promotions","title":"Promotions","subscribed":true}]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Newsletter.SetTopics(map[TopicID]bool{
+		TopicProductUpdates: true,
+		TopicPromotions:     true,
+	}).Current(current.Result.Topics).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Topics[0].Subscribed, Equals, true)
+	chk.Check(requests, Equals, 1)
+
+	res, err = svc.Me.Newsletter.SetTopics(map[TopicID]bool{TopicBetaInvites: false}).
+		Current(current.Result.Topics).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Topics, HasLen, 4)
+	chk.Check(requests, Equals, 1)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Newsletter_SetTopicsUnknown(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"code":40003,"message":"unknown topic","details":{"topic_id":"weekly_digest"}}`)
+	})
+	defer ts.Close()
+
+	_, err := svc.Me.Newsletter.SetTopics(map[TopicID]bool{"weekly_digest": true}).Do()
+	chk.Check(errors.Is(err, ErrUnknownTopic), Equals, true)
+	id, ok := UnknownTopic(err)
+	chk.Check(ok, Equals, true)
+	chk.Check(id, Equals, TopicID("weekly_digest"))
+
+	_, ok = UnknownTopic(errors.New("other"))
+	chk.Check(ok, Equals, false)
+}
@@ -0,0 +1,123 @@
+package account
+
+import (
+	"net/url"
+	"time"
+)
+
+// RouteStatus is the verification state of an alternate notification
+// address.
+type RouteStatus string
+
+const (
+	// RoutePending means the address awaits confirmation; notifications
+	// still go to the account address meanwhile.
+	RoutePending  RouteStatus = "pending"
+	RouteVerified RouteStatus = "verified"
+)
+
+// A NotificationRoute sends the email notifications of a category to an
+// address other than the account one.
+type NotificationRoute struct {
+	Category NotificationCategory `json:"category"`
+	Email    string               `json:"email"`
+	Status   RouteStatus          `json:"status"`
+}
+
+type NotificationRoutesResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Routes []*NotificationRoute `json:"routes"`
+	} `json:"result"`
+}
+
+type NotificationsRoutesCall struct {
+	s *Service
+}
+
+// Routes lists the categories whose email notifications go to an alternate
+// address.
+func (r *NotificationsService) Routes() *NotificationsRoutesCall {
+	c := &NotificationsRoutesCall{s: r.s}
+	return c
+}
+
+func (c *NotificationsRoutesCall) Do() (*NotificationRoutesResponse, error) {
+	path := versioned("me/notifications/routes")
+	ret := &NotificationRoutesResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type NotificationRouteResponse struct {
+	Message string            `json:"message"`
+	Code    int               `json:"code"`
+	Result  NotificationRoute `json:"result"`
+}
+
+type NotificationsSetRouteCall struct {
+	s        *Service
+	category NotificationCategory
+	email    string
+}
+
+// SetRoute sends the email notifications of category to email. A
+// verification email is sent to the address, and the route stays
+// RoutePending until it is confirmed. An empty email removes the route,
+// so notifications go back to the account address.
+func (r *NotificationsService) SetRoute(category NotificationCategory, email string) *NotificationsSetRouteCall {
+	c := &NotificationsSetRouteCall{s: r.s, category: category, email: email}
+	return c
+}
+
+func (c *NotificationsSetRouteCall) Do() (*NotificationRouteResponse, error) {
+	if c.email != "" {
+		if err := validateEmail(c.email); err != nil {
+			return nil, err
+		}
+	}
+	path := versioned("me/notifications/routes/" + url.PathEscape(string(c.category)))
+	payload := struct {
+		Email string `json:"email"`
+	}{c.email}
+	ret := &NotificationRouteResponse{}
+	_, err := c.s.put(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type NotificationsResendRouteVerificationResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		ExpiresAt time.Time `json:"expires_at"`
+	} `json:"result"`
+}
+
+type NotificationsResendRouteVerificationCall struct {
+	s        *Service
+	category NotificationCategory
+}
+
+// ResendRouteVerification sends the verification email of the pending
+// route of category again.
+func (r *NotificationsService) ResendRouteVerification(category NotificationCategory) *NotificationsResendRouteVerificationCall {
+	c := &NotificationsResendRouteVerificationCall{s: r.s, category: category}
+	return c
+}
+
+func (c *NotificationsResendRouteVerificationCall) Do() (*NotificationsResendRouteVerificationResponse, error) {
+	path := versioned("me/notifications/routes/" + url.PathEscape(string(c.category)) + "/verification/resend")
+	ret := &NotificationsResendRouteVerificationResponse{}
+	_, err := c.s.post(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,75 @@
+package account
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_NotificationRoutes_List(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/notifications/routes")
+		fmt.Fprint(w, `{"code":0,"result":{"routes":[
+			{"category":"security_alerts","email":"sec@example.com","status":"verified"},
+			{"category":"product_news","email":"news@example.com","status":"pending"}
+		]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Notifications.Routes().Do()
+	chk.Assert(err, IsNil)
+	rt := res.Result.Routes
+	chk.Assert(rt, HasLen, 2)
+	chk.Check(rt[0].Category, Equals, NotifySecurityAlerts)
+	chk.Check(rt[0].Status, Equals, RouteVerified)
+	chk.Check(rt[1].Email, Equals, "news@example.com")
+	chk.Check(rt[1].Status, Equals, RoutePending)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_NotificationRoutes_SetPending(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "PUT")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/notifications/routes/security_alerts")
+		body, _ := ioutil.ReadAll(r.Body)
+		chk.Check(string(body), Equals, `{"email":"sec@example.com"}`+"\n")
+		fmt.Fprint(w, `{"code":0,"result":{"category":"security_alerts","email":"sec@example.com","status":"pending"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Notifications.SetRoute(NotifySecurityAlerts, "sec@example.com").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Status, Equals, RoutePending)
+
+	_, err = svc.Me.Notifications.SetRoute(NotifySecurityAlerts, "Sec <sec@example.com>").Do()
+	chk.Check(err, ErrorMatches, "account: invalid email address .*")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_NotificationRoutes_Remove(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "PUT")
+		body, _ := ioutil.ReadAll(r.Body)
+		chk.Check(string(body), Equals, `{"email":""}`+"\n")
+		fmt.Fprint(w, `{"code":0,"result":{"category":"security_alerts","email":""}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Notifications.SetRoute(NotifySecurityAlerts, "").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Email, Equals, "")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_NotificationRoutes_Resend(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/notifications/routes/product_news/verification/resend")
+		fmt.Fprint(w, `{"code":0,"result":{"expires_at":"2017-03-01T00:00:00Z"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Notifications.ResendRouteVerification(NotifyProductNews).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.ExpiresAt.IsZero(), Equals, false)
+}
@@ -0,0 +1,146 @@
+package account
+
+import "encoding/json"
+
+// NotificationCategory identifies a kind of notification.
+type NotificationCategory string
+
+const (
+	NotifySecurityAlerts NotificationCategory = "security_alerts"
+	NotifyProductNews    NotificationCategory = "product_news"
+	NotifyFriendRequests NotificationCategory = "friend_requests"
+)
+
+// NotificationChannels tells on which channels a category is delivered.
+type NotificationChannels struct {
+	Email bool `json:"email"`
+	Push  bool `json:"push"`
+}
+
+// NotificationSettings is the per-category notification matrix of the
+// account.
+type NotificationSettings struct {
+	SecurityAlerts NotificationChannels
+	ProductNews    NotificationChannels
+	FriendRequests NotificationChannels
+
+	// Other holds the categories unknown to this package, so they survive
+	// a decode/encode round trip.
+	Other map[NotificationCategory]NotificationChannels
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NotificationSettings) UnmarshalJSON(b []byte) error {
+	var m map[NotificationCategory]NotificationChannels
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	*n = NotificationSettings{}
+	for cat, ch := range m {
+		switch cat {
+		case NotifySecurityAlerts:
+			n.SecurityAlerts = ch
+		case NotifyProductNews:
+			n.ProductNews = ch
+		case NotifyFriendRequests:
+			n.FriendRequests = ch
+		default:
+			if n.Other == nil {
+				n.Other = make(map[NotificationCategory]NotificationChannels)
+			}
+			n.Other[cat] = ch
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n NotificationSettings) MarshalJSON() ([]byte, error) {
+	m := make(map[NotificationCategory]NotificationChannels, len(n.Other)+3)
+	for cat, ch := range n.Other {
+		m[cat] = ch
+	}
+	m[NotifySecurityAlerts] = n.SecurityAlerts
+	m[NotifyProductNews] = n.ProductNews
+	m[NotifyFriendRequests] = n.FriendRequests
+	return json.Marshal(m)
+}
+
+type NotificationSettingsResponse struct {
+	Message string               `json:"message"`
+	Code    int                  `json:"code"`
+	Result  NotificationSettings `json:"result"`
+}
+
+type NotificationsService struct {
+	s *Service
+}
+
+func NewNotificationsService(s *Service) *NotificationsService {
+	rs := &NotificationsService{s: s}
+	return rs
+}
+
+type NotificationsGetSettingsCall struct {
+	s *Service
+}
+
+// GetSettings retrieves the notification settings of the account.
+func (r *NotificationsService) GetSettings() *NotificationsGetSettingsCall {
+	c := &NotificationsGetSettingsCall{s: r.s}
+	return c
+}
+
+func (c *NotificationsGetSettingsCall) Do() (*NotificationSettingsResponse, error) {
+	path := versioned("me/notifications/settings")
+	ret := &NotificationSettingsResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type NotificationsUpdateSettingsCall struct {
+	s     *Service
+	patch patch
+}
+
+// UpdateSettings changes the notification settings of the account. Only
+// the categories set on the call are sent; the others stay unchanged.
+func (r *NotificationsService) UpdateSettings() *NotificationsUpdateSettingsCall {
+	c := &NotificationsUpdateSettingsCall{s: r.s, patch: patch{}}
+	return c
+}
+
+// Set sets the channels of any category, including ones unknown to this
+// package.
+func (c *NotificationsUpdateSettingsCall) Set(cat NotificationCategory, ch NotificationChannels) *NotificationsUpdateSettingsCall {
+	c.patch[string(cat)] = ch
+	return c
+}
+
+// SecurityAlerts sets the channels of security alerts.
+func (c *NotificationsUpdateSettingsCall) SecurityAlerts(ch NotificationChannels) *NotificationsUpdateSettingsCall {
+	return c.Set(NotifySecurityAlerts, ch)
+}
+
+// ProductNews sets the channels of product news.
+func (c *NotificationsUpdateSettingsCall) ProductNews(ch NotificationChannels) *NotificationsUpdateSettingsCall {
+	return c.Set(NotifyProductNews, ch)
+}
+
+// FriendRequests sets the channels of friend requests.
+func (c *NotificationsUpdateSettingsCall) FriendRequests(ch NotificationChannels) *NotificationsUpdateSettingsCall {
+	return c.Set(NotifyFriendRequests, ch)
+}
+
+func (c *NotificationsUpdateSettingsCall) Do() (*NotificationSettingsResponse, error) {
+	path := versioned("me/notifications/settings")
+	ret := &NotificationSettingsResponse{}
+	_, err := c.s.patch(path, c.patch, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
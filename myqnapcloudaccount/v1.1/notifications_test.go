@@ -0,0 +1,61 @@
+package account
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+const notificationSettingsFixture = `{"code":0,"result":{
+	"security_alerts":{"email":true,"push":true},
+	"product_news":{"email":false,"push":false},
+	"friend_requests":{"email":true,"push":false},
+	"beta_programs":{"email":true,"push":false}
+}}`
+
+func (s *MySuite) Test_Myqnapcloud_Account_Notifications_GetSettings(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/notifications/settings")
+		fmt.Fprint(w, notificationSettingsFixture)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Notifications.GetSettings().Do()
+	chk.Assert(err, IsNil)
+	set := res.Result
+	chk.Check(set.SecurityAlerts, Equals, NotificationChannels{Email: true, Push: true})
+	chk.Check(set.ProductNews, Equals, NotificationChannels{})
+	chk.Check(set.FriendRequests, Equals, NotificationChannels{Email: true})
+	chk.Check(set.Other, DeepEquals, map[NotificationCategory]NotificationChannels{
+		"beta_programs": {Email: true},
+	})
+
+	b, err := json.Marshal(set)
+	chk.Assert(err, IsNil)
+	var got, want map[string]interface{}
+	chk.Assert(json.Unmarshal(b, &got), IsNil)
+	chk.Assert(json.Unmarshal([]byte(notificationSettingsFixture), &want), IsNil)
+	chk.Check(got, DeepEquals, want["result"])
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Notifications_UpdateSettings(chk *C) {
+	var body string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "PATCH")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/notifications/settings")
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		fmt.Fprint(w, notificationSettingsFixture)
+	})
+	defer ts.Close()
+
+	_, err := svc.Me.Notifications.UpdateSettings().
+		ProductNews(NotificationChannels{}).
+		Set("beta_programs", NotificationChannels{Email: true}).
+		Do()
+	chk.Assert(err, IsNil)
+	chk.Check(body, Equals, `{"beta_programs":{"email":true,"push":false},"product_news":{"email":false,"push":false}}`+"\n")
+}
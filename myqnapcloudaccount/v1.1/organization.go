@@ -0,0 +1,62 @@
+package account
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNoOrganization is returned, and matched by errors.Is, when the
+// account does not belong to an organization.
+var ErrNoOrganization = errors.New("account: no organization")
+
+const codeNoOrganization = 40412
+
+// Plan is the subscription plan of an organization. Plans unknown to this
+// package are kept verbatim.
+type Plan string
+
+const (
+	PlanStarter    Plan = "starter"
+	PlanBusiness   Plan = "business"
+	PlanEnterprise Plan = "enterprise"
+)
+
+// An Organization is a team of accounts sharing devices.
+type Organization struct {
+	OrganizationID string    `json:"organization_id"`
+	Name           string    `json:"name"`
+	Plan           Plan      `json:"plan"`
+	SeatsUsed      int       `json:"seats_used"`
+	SeatsTotal     int       `json:"seats_total"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type OrganizationGetResponse struct {
+	Message string       `json:"message"`
+	Code    int          `json:"code"`
+	Result  Organization `json:"result"`
+}
+
+type OrganizationGetCall struct {
+	s *Service
+}
+
+// Get retrieves the organization of the account. Accounts that belong to
+// none yield ErrNoOrganization.
+func (r *OrganizationService) Get() *OrganizationGetCall {
+	c := &OrganizationGetCall{s: r.s}
+	return c
+}
+
+func (c *OrganizationGetCall) Do() (*OrganizationGetResponse, error) {
+	path := versioned("organization")
+	ret := &OrganizationGetResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Result.OrganizationID == "" {
+		return nil, ErrNoOrganization
+	}
+	return ret, nil
+}
@@ -0,0 +1,49 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Organization_Get(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/organization")
+		fmt.Fprint(w, `{"code":0,"result":{"organization_id":"o1","name":"Qeek Lab","plan":"business",
+			"seats_used":12,"seats_total":25,"created_at":"2016-05-01T08:00:00Z"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Organization.Get().Do()
+	chk.Assert(err, IsNil)
+	o := res.Result
+	chk.Check(o.OrganizationID, Equals, "o1")
+	chk.Check(o.Name, Equals, "Qeek Lab")
+	chk.Check(o.Plan, Equals, PlanBusiness)
+	chk.Check(o.SeatsUsed, Equals, 12)
+	chk.Check(o.SeatsTotal, Equals, 25)
+	chk.Check(o.CreatedAt.Equal(time.Date(2016, 5, 1, 8, 0, 0, 0, time.UTC)), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Organization_Get_None(chk *C) {
+	for _, t := range []struct {
+		status int
+		body   string
+	}{
+		{http.StatusNotFound, `{"code":40412,"message":"no organization"}`},
+		{http.StatusOK, `{"code":0,"result":null}`},
+	} {
+		svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(t.status)
+			fmt.Fprint(w, t.body)
+		})
+
+		_, err := svc.Organization.Get().Do()
+		chk.Check(errors.Is(err, ErrNoOrganization), Equals, true, Commentf(t.body))
+		ts.Close()
+	}
+}
@@ -0,0 +1,243 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrLastOwner is matched by errors.Is when removing or demoting the last
+// owner of an organization.
+var ErrLastOwner = errors.New("account: cannot remove the last organization owner")
+
+const codeLastOwner = 40921
+
+// Role is the role of a member in an organization: either a builtin role
+// or the ID of a custom role, as listed by Organization.Roles.
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+)
+
+// IsKnown reports whether r is one of the documented roles.
+func (r Role) IsKnown() bool {
+	switch r {
+	case RoleOwner, RoleAdmin, RoleMember:
+		return true
+	}
+	return false
+}
+
+// IsCustom reports whether r is the ID of a custom role, that is any
+// non-blank ID other than a builtin role. It does not check that the role
+// exists.
+func (r Role) IsCustom() bool {
+	return !r.IsKnown() && strings.TrimSpace(string(r)) != ""
+}
+
+// A CustomRole is the ID of a custom role of the organization, as listed
+// by Organization.Roles.
+type CustomRole string
+
+// A MemberRole is a role given to a member by Invite or ChangeRole:
+// either one of the builtin Role constants or a CustomRole. Builtin roles
+// are checked before any request is sent, so that a misspelled one is
+// not taken for a custom role.
+type MemberRole interface {
+	roleID() (string, error)
+}
+
+func (r Role) roleID() (string, error) {
+	if !r.IsKnown() {
+		return "", fmt.Errorf("account: invalid role %q", r)
+	}
+	return string(r), nil
+}
+
+func (r CustomRole) roleID() (string, error) {
+	if strings.TrimSpace(string(r)) == "" {
+		return "", fmt.Errorf("account: invalid custom role %q", r)
+	}
+	return string(r), nil
+}
+
+// An OrganizationMember is an account in an organization.
+type OrganizationMember struct {
+	UserID      string    `json:"user_id"`
+	DisplayName string    `json:"display_name"`
+	Email       string    `json:"email"`
+	Role        Role      `json:"role"`
+	JoinedAt    time.Time `json:"joined_at"`
+
+	// Pending is set for invited users who did not join yet; JoinedAt is
+	// zero then.
+	Pending bool `json:"pending"`
+}
+
+type OrganizationMembersService struct {
+	s *Service
+}
+
+func NewOrganizationMembersService(s *Service) *OrganizationMembersService {
+	rs := &OrganizationMembersService{s: s}
+	return rs
+}
+
+type OrganizationMembersListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Members       []*OrganizationMember `json:"members"`
+		NextPageToken string                `json:"next_page_token"`
+	} `json:"result"`
+}
+
+type OrganizationMembersListCall struct {
+	s      *Service
+	params url.Values
+}
+
+// List lists the members of the organization, including pending ones.
+func (r *OrganizationMembersService) List() *OrganizationMembersListCall {
+	c := &OrganizationMembersListCall{s: r.s, params: url.Values{}}
+	return c
+}
+
+// PageSize sets the maximum number of members returned per page.
+func (c *OrganizationMembersListCall) PageSize(n int) *OrganizationMembersListCall {
+	c.params.Set("page_size", strconv.Itoa(n))
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *OrganizationMembersListCall) PageToken(token string) *OrganizationMembersListCall {
+	if token == "" {
+		c.params.Del("page_token")
+		return c
+	}
+	c.params.Set("page_token", token)
+	return c
+}
+
+func (c *OrganizationMembersListCall) Do() (*OrganizationMembersListResponse, error) {
+	return c.doContext(context.Background())
+}
+
+func (c *OrganizationMembersListCall) doContext(ctx context.Context) (*OrganizationMembersListResponse, error) {
+	path := versioned("organization/members")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &OrganizationMembersListResponse{}
+	_, err := c.s.getContext(ctx, path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Pages calls f for each page of the listing, from the page set with
+// PageToken on; see pages for the details.
+func (c *OrganizationMembersListCall) Pages(ctx context.Context, f func(*OrganizationMembersListResponse) error) error {
+	return pages(ctx, c.params, func() (string, error) {
+		res, err := c.doContext(ctx)
+		if err != nil {
+			return "", err
+		}
+		return res.Result.NextPageToken, f(res)
+	})
+}
+
+type OrganizationMemberResponse struct {
+	Message string             `json:"message"`
+	Code    int                `json:"code"`
+	Result  OrganizationMember `json:"result"`
+}
+
+type OrganizationMembersInviteCall struct {
+	s     *Service
+	email string
+	role  MemberRole
+}
+
+// Invite invites the owner of an email address to join the organization
+// with the given role. The member is pending until they accept.
+func (r *OrganizationMembersService) Invite(email string, role MemberRole) *OrganizationMembersInviteCall {
+	c := &OrganizationMembersInviteCall{s: r.s, email: email, role: role}
+	return c
+}
+
+func (c *OrganizationMembersInviteCall) Do() (*OrganizationMemberResponse, error) {
+	if err := validateEmail(c.email); err != nil {
+		return nil, err
+	}
+	role, err := c.role.roleID()
+	if err != nil {
+		return nil, err
+	}
+	path := versioned("organization/members")
+	payload := struct {
+		Email string `json:"email"`
+		Role  string `json:"role"`
+	}{c.email, role}
+	ret := &OrganizationMemberResponse{}
+	_, err = c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type OrganizationMembersChangeRoleCall struct {
+	s      *Service
+	userID string
+	role   MemberRole
+}
+
+// ChangeRole changes the role of a member. Demoting the last owner fails
+// with an error matching ErrLastOwner.
+func (r *OrganizationMembersService) ChangeRole(userID string, role MemberRole) *OrganizationMembersChangeRoleCall {
+	c := &OrganizationMembersChangeRoleCall{s: r.s, userID: userID, role: role}
+	return c
+}
+
+func (c *OrganizationMembersChangeRoleCall) Do() (*OrganizationMemberResponse, error) {
+	role, err := c.role.roleID()
+	if err != nil {
+		return nil, err
+	}
+	path := versioned("organization/members/" + url.PathEscape(c.userID))
+	ret := &OrganizationMemberResponse{}
+	_, err = c.s.patch(path, patch{"role": role}, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type OrganizationMembersRemoveCall struct {
+	s      *Service
+	userID string
+}
+
+// Remove removes a member from the organization, or cancels the
+// invitation of a pending one. Removing the last owner fails with an
+// error matching ErrLastOwner.
+func (r *OrganizationMembersService) Remove(userID string) *OrganizationMembersRemoveCall {
+	c := &OrganizationMembersRemoveCall{s: r.s, userID: userID}
+	return c
+}
+
+func (c *OrganizationMembersRemoveCall) Do() error {
+	path := versioned("organization/members/" + url.PathEscape(c.userID))
+	_, err := c.s.delete(path, nil, nil)
+	return err
+}
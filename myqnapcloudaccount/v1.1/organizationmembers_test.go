@@ -0,0 +1,138 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+// fakeOrganizationMembersAPI keeps the members of an organization in
+// memory, one per page. u0 is its only owner.
+func fakeOrganizationMembersAPI(chk *C) http.HandlerFunc {
+	type member struct {
+		UserID  string `json:"user_id"`
+		Email   string `json:"email"`
+		Role    string `json:"role"`
+		Pending bool   `json:"pending"`
+	}
+	members := map[string]*member{"u0": {UserID: "u0", Email: "owner@example.com", Role: "owner"}}
+	owners := func() int {
+		n := 0
+		for _, m := range members {
+			if m.Role == "owner" {
+				n++
+			}
+		}
+		return n
+	}
+	lastOwner := func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"code":40921,"message":"last owner"}`)
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/v1.1/organization/members/")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/v1.1/organization/members":
+			var ids []string
+			for id := range members {
+				ids = append(ids, id)
+			}
+			sort.Strings(ids)
+			i := 0
+			if token := r.URL.Query().Get("page_token"); token != "" {
+				fmt.Sscanf(token, "p%d", &i)
+			}
+			next := ""
+			if i+1 < len(ids) {
+				next = fmt.Sprintf("p%d", i+1)
+			}
+			b, _ := json.Marshal(members[ids[i]])
+			fmt.Fprintf(w, `{"code":0,"result":{"members":[%s],"next_page_token":%q}}`, b, next)
+		case r.Method == "POST" && r.URL.Path == "/v1.1/organization/members":
+			m := &member{UserID: "u1", Pending: true}
+			chk.Check(json.NewDecoder(r.Body).Decode(m), IsNil)
+			members[m.UserID] = m
+			json.NewEncoder(w).Encode(map[string]interface{}{"code": 0, "result": m})
+		case r.Method == "PATCH" && members[id] != nil:
+			var body map[string]string
+			chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+			if members[id].Role == "owner" && body["role"] != "owner" && owners() == 1 {
+				lastOwner(w)
+				return
+			}
+			members[id].Role = body["role"]
+			json.NewEncoder(w).Encode(map[string]interface{}{"code": 0, "result": members[id]})
+		case r.Method == "DELETE" && members[id] != nil:
+			if members[id].Role == "owner" && owners() == 1 {
+				lastOwner(w)
+				return
+			}
+			delete(members, id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":40400,"message":"member not found"}`)
+		}
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Organization_Members(chk *C) {
+	svc, ts := newFakeService(fakeOrganizationMembersAPI(chk))
+	defer ts.Close()
+	members := svc.Organization.Members
+
+	invited, err := members.Invite("bob@example.com", RoleMember).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(invited.Result.UserID, Equals, "u1")
+	chk.Check(invited.Result.Pending, Equals, true)
+	chk.Check(invited.Result.JoinedAt.IsZero(), Equals, true)
+
+	var all []*OrganizationMember
+	err = members.List().Pages(context.Background(), func(res *OrganizationMembersListResponse) error {
+		all = append(all, res.Result.Members...)
+		return nil
+	})
+	chk.Assert(err, IsNil)
+	chk.Assert(all, HasLen, 2)
+	chk.Check(all[0].Role, Equals, RoleOwner)
+	chk.Check(all[1].Email, Equals, "bob@example.com")
+
+	changed, err := members.ChangeRole("u1", RoleAdmin).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(changed.Result.Role, Equals, RoleAdmin)
+
+	_, err = members.ChangeRole("u0", RoleMember).Do()
+	chk.Check(errors.Is(err, ErrLastOwner), Equals, true)
+	chk.Check(errors.Is(members.Remove("u0").Do(), ErrLastOwner), Equals, true)
+
+	_, err = members.ChangeRole("u1", RoleOwner).Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(members.Remove("u0").Do(), IsNil)
+
+	res, err := members.List().Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(res.Result.Members, HasLen, 1)
+	chk.Check(res.Result.Members[0].UserID, Equals, "u1")
+	chk.Check(res.Result.NextPageToken, Equals, "")
+
+	err = members.Remove("u0").Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Organization_Members_InvalidRole(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Error("unexpected request")
+	})
+	defer ts.Close()
+
+	_, err := svc.Organization.Members.Invite("bob@example.com", Role("superuser")).Do()
+	chk.Check(err, ErrorMatches, `account: invalid role "superuser"`)
+	_, err = svc.Organization.Members.ChangeRole("u1", Role("")).Do()
+	chk.Check(err, ErrorMatches, `account: invalid role ""`)
+}
@@ -0,0 +1,90 @@
+package account
+
+import "net/url"
+
+// Permission is a permission granted by an organization role. Permissions
+// unknown to this package are kept verbatim.
+type Permission string
+
+const (
+	PermissionViewDevices   Permission = "devices:read"
+	PermissionManageDevices Permission = "devices:manage"
+	PermissionManageMembers Permission = "members:manage"
+	PermissionManageBilling Permission = "billing:manage"
+)
+
+// An OrganizationRole is a role members of an organization can be given.
+type OrganizationRole struct {
+	// ID is the builtin role, or the ID of a custom role.
+	ID   Role   `json:"role_id"`
+	Name string `json:"name"`
+
+	// Builtin is set for RoleOwner, RoleAdmin and RoleMember, and unset
+	// for custom roles.
+	Builtin     bool         `json:"builtin"`
+	Permissions []Permission `json:"permissions"`
+}
+
+type OrganizationRolesService struct {
+	s *Service
+}
+
+func NewOrganizationRolesService(s *Service) *OrganizationRolesService {
+	rs := &OrganizationRolesService{s: s}
+	return rs
+}
+
+type OrganizationRolesListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Roles []*OrganizationRole `json:"roles"`
+	} `json:"result"`
+}
+
+type OrganizationRolesListCall struct {
+	s *Service
+}
+
+// List lists the roles of the organization, builtin ones included.
+func (r *OrganizationRolesService) List() *OrganizationRolesListCall {
+	c := &OrganizationRolesListCall{s: r.s}
+	return c
+}
+
+func (c *OrganizationRolesListCall) Do() (*OrganizationRolesListResponse, error) {
+	path := versioned("organization/roles")
+	ret := &OrganizationRolesListResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type OrganizationRoleGetResponse struct {
+	Message string           `json:"message"`
+	Code    int              `json:"code"`
+	Result  OrganizationRole `json:"result"`
+}
+
+type OrganizationRolesGetCall struct {
+	s  *Service
+	id Role
+}
+
+// Get retrieves a role of the organization.
+func (r *OrganizationRolesService) Get(roleID Role) *OrganizationRolesGetCall {
+	c := &OrganizationRolesGetCall{s: r.s, id: roleID}
+	return c
+}
+
+func (c *OrganizationRolesGetCall) Do() (*OrganizationRoleGetResponse, error) {
+	path := versioned("organization/roles/" + url.PathEscape(string(c.id)))
+	ret := &OrganizationRoleGetResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
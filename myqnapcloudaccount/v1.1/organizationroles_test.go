@@ -0,0 +1,72 @@
+package account
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Organization_Roles(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		switch r.URL.Path {
+		case "/v1.1/organization/roles":
+			fmt.Fprint(w, `{"code":0,"result":{"roles":[
+				{"role_id":"admin","name":"Administrator","builtin":true,
+				 "permissions":["devices:read","devices:manage","members:manage"]},
+				{"role_id":"role_7f3a","name":"Lab technician","builtin":false,
+				 "permissions":["devices:read","firmware:update"]}
+			]}}`)
+		case "/v1.1/organization/roles/role_7f3a":
+			fmt.Fprint(w, `{"code":0,"result":{"role_id":"role_7f3a","name":"Lab technician",
+				"permissions":["devices:read","firmware:update"]}}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Organization.Roles.List().Do()
+	chk.Assert(err, IsNil)
+	roles := res.Result.Roles
+	chk.Assert(roles, HasLen, 2)
+	chk.Check(roles[0].ID, Equals, RoleAdmin)
+	chk.Check(roles[0].Builtin, Equals, true)
+	chk.Check(roles[0].Permissions, DeepEquals, []Permission{PermissionViewDevices, PermissionManageDevices, PermissionManageMembers})
+	chk.Check(roles[1].ID.IsCustom(), Equals, true)
+	chk.Check(roles[1].Builtin, Equals, false)
+	chk.Check(roles[1].Permissions, DeepEquals, []Permission{PermissionViewDevices, Permission("firmware:update")})
+
+	got, err := svc.Organization.Roles.Get("role_7f3a").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(got.Result.Name, Equals, "Lab technician")
+	chk.Check(got.Result.Permissions[1], Equals, Permission("firmware:update"))
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Organization_Members_CustomRole(chk *C) {
+	var sent []string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		sent = append(sent, body["role"])
+		fmt.Fprintf(w, `{"code":0,"result":{"user_id":"u1","role":%q}}`, body["role"])
+	})
+	defer ts.Close()
+
+	res, err := svc.Organization.Members.ChangeRole("u1", CustomRole("role_7f3a")).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Role, Equals, Role("role_7f3a"))
+	_, err = svc.Organization.Members.Invite("bob@example.com", CustomRole("role_7f3a")).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(sent, DeepEquals, []string{"role_7f3a", "role_7f3a"})
+
+	// Custom role IDs have no fixed format, but must be given explicitly.
+	chk.Check(res.Result.Role.IsCustom(), Equals, true)
+	chk.Check(RoleAdmin.IsCustom(), Equals, false)
+	_, err = svc.Organization.Members.ChangeRole("u1", CustomRole("lab-technician")).Do()
+	chk.Assert(err, IsNil)
+	_, err = svc.Organization.Members.ChangeRole("u1", Role("lab-technician")).Do()
+	chk.Check(err, ErrorMatches, `account: invalid role "lab-technician"`)
+	_, err = svc.Organization.Members.ChangeRole("u1", CustomRole(" ")).Do()
+	chk.Check(err, ErrorMatches, `account: invalid custom role " "`)
+}
@@ -0,0 +1,125 @@
+package account
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+var (
+	// ErrWrongCode is matched by errors.Is when a verification code does
+	// not match. See RemainingAttempts.
+	ErrWrongCode = errors.New("account: wrong verification code")
+
+	// ErrChallengeExpired is matched by errors.Is when a verification
+	// challenge expired or ran out of attempts.
+	ErrChallengeExpired = errors.New("account: verification challenge expired")
+)
+
+const (
+	codeWrongCode        = 40002
+	codeChallengeExpired = 41001
+)
+
+var e164 = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// RemainingAttempts returns the number of verification attempts left on
+// the challenge that failed with err, when the API reported it.
+func RemainingAttempts(err error) (int, bool) {
+	var e *ErrorResponse
+	if !errors.As(err, &e) || len(e.Details) == 0 {
+		return 0, false
+	}
+	var details struct {
+		RemainingAttempts *int `json:"remaining_attempts"`
+	}
+	if json.Unmarshal(e.Details, &details) != nil || details.RemainingAttempts == nil {
+		return 0, false
+	}
+	return *details.RemainingAttempts, true
+}
+
+type PhoneService struct {
+	s *Service
+}
+
+func NewPhoneService(s *Service) *PhoneService {
+	rs := &PhoneService{s: s}
+	return rs
+}
+
+type PhoneUpdateResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		ChallengeID string    `json:"challenge_id"`
+		ExpiresAt   time.Time `json:"expires_at"`
+	} `json:"result"`
+}
+
+type PhoneUpdateRequestCall struct {
+	s      *Service
+	number string
+}
+
+// UpdateRequest starts changing the account mobile number. An SMS with a
+// verification code is sent to number, which must be in E.164 format
+// (e.g. +886912345678); the change completes with Verify.
+func (r *PhoneService) UpdateRequest(e164Number string) *PhoneUpdateRequestCall {
+	c := &PhoneUpdateRequestCall{s: r.s, number: e164Number}
+	return c
+}
+
+func (c *PhoneUpdateRequestCall) Do() (*PhoneUpdateResponse, error) {
+	if !e164.MatchString(c.number) {
+		return nil, fmt.Errorf("account: %q is not an E.164 phone number", c.number)
+	}
+	path := versioned("me/phone/change")
+	payload := struct {
+		MobileNumber string `json:"mobile_number"`
+	}{c.number}
+	ret := &PhoneUpdateResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type PhoneVerifyResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		MobileNumber string `json:"mobile_number"`
+	} `json:"result"`
+}
+
+type PhoneVerifyCall struct {
+	s           *Service
+	challengeID string
+	code        string
+}
+
+// Verify completes a mobile number change with the code received by SMS.
+// A wrong code yields an error matching ErrWrongCode; the challenge can be
+// retried while RemainingAttempts reports attempts left.
+func (r *PhoneService) Verify(challengeID, code string) *PhoneVerifyCall {
+	c := &PhoneVerifyCall{s: r.s, challengeID: challengeID, code: code}
+	return c
+}
+
+func (c *PhoneVerifyCall) Do() (*PhoneVerifyResponse, error) {
+	path := versioned("me/phone/change/verify")
+	payload := struct {
+		ChallengeID string `json:"challenge_id"`
+		Code        string `json:"code"`
+	}{c.challengeID, c.code}
+	ret := &PhoneVerifyResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
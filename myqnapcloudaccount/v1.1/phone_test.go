@@ -0,0 +1,89 @@
+package account
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+// fakePhoneAPI walks a single SMS challenge allowing three attempts.
+func fakePhoneAPI(chk *C, code string) http.HandlerFunc {
+	attempts := 3
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		switch r.URL.Path {
+		case "/v1.1/me/phone/change":
+			chk.Check(body["mobile_number"], Equals, "+886912345678")
+			fmt.Fprint(w, `{"code":0,"result":{"challenge_id":"ch1","expires_at":"2017-02-23T08:10:00Z"}}`)
+		case "/v1.1/me/phone/change/verify":
+			chk.Check(body["challenge_id"], Equals, "ch1")
+			if attempts == 0 {
+				w.WriteHeader(http.StatusGone)
+				fmt.Fprint(w, `{"code":41001,"message":"challenge expired"}`)
+				return
+			}
+			if body["code"] != code {
+				attempts--
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, `{"code":40002,"message":"wrong code","details":{"remaining_attempts":%d}}`, attempts)
+				return
+			}
+			fmt.Fprint(w, `{"code":0,"result":{"mobile_number":"+886912345678"}}`)
+		}
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Phone_Update(chk *C) {
+	svc, ts := newFakeService(fakePhoneAPI(chk, "123456"))
+	defer ts.Close()
+
+	req, err := svc.Me.Phone.UpdateRequest("+886912345678").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(req.Result.ChallengeID, Equals, "ch1")
+
+	res, err := svc.Me.Phone.Verify(req.Result.ChallengeID, "123456").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.MobileNumber, Equals, "+886912345678")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Phone_Verify_Retry(chk *C) {
+	svc, ts := newFakeService(fakePhoneAPI(chk, "123456"))
+	defer ts.Close()
+
+	_, err := svc.Me.Phone.Verify("ch1", "000000").Do()
+	chk.Check(errors.Is(err, ErrWrongCode), Equals, true)
+	n, ok := RemainingAttempts(err)
+	chk.Check(ok, Equals, true)
+	chk.Check(n, Equals, 2)
+
+	res, err := svc.Me.Phone.Verify("ch1", "123456").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.MobileNumber, Equals, "+886912345678")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Phone_Verify_Expired(chk *C) {
+	svc, ts := newFakeService(fakePhoneAPI(chk, "123456"))
+	defer ts.Close()
+
+	for i := 0; i < 3; i++ {
+		_, err := svc.Me.Phone.Verify("ch1", "000000").Do()
+		chk.Assert(errors.Is(err, ErrWrongCode), Equals, true)
+	}
+	_, err := svc.Me.Phone.Verify("ch1", "123456").Do()
+	chk.Check(errors.Is(err, ErrChallengeExpired), Equals, true)
+	chk.Check(errors.Is(err, ErrWrongCode), Equals, false)
+	_, ok := RemainingAttempts(err)
+	chk.Check(ok, Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Phone_UpdateRequest_Invalid(chk *C) {
+	svc := New(nil)
+	for _, n := range []string{"0912345678", "+0912345678", "+8869123456789012", "+886 912 345 678"} {
+		_, err := svc.Me.Phone.UpdateRequest(n).Do()
+		chk.Check(err, ErrorMatches, ".*not an E.164 phone number", Commentf(n))
+	}
+}
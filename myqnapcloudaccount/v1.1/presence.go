@@ -0,0 +1,58 @@
+package account
+
+import "time"
+
+// PresenceState is the online status of a user.
+type PresenceState string
+
+const (
+	PresenceOnline  PresenceState = "online"
+	PresenceAway    PresenceState = "away"
+	PresenceOffline PresenceState = "offline"
+
+	// PresencePrivate is reported for users who do not share their
+	// presence.
+	PresencePrivate PresenceState = "private"
+)
+
+// A UserPresence is the online status of a user.
+type UserPresence struct {
+	UserID string        `json:"user_id"`
+	State  PresenceState `json:"state"`
+
+	// LastSeen is when the user was last online. It is zero when withheld
+	// by the privacy settings of the user.
+	LastSeen time.Time `json:"last_seen"`
+}
+
+type UserPresenceResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Presences []*UserPresence `json:"presences"`
+	} `json:"result"`
+}
+
+type UserPresenceCall struct {
+	s   *Service
+	ids []string
+}
+
+// Presence retrieves the online status of several users at once.
+func (r *UserService) Presence(userIDs []string) *UserPresenceCall {
+	c := &UserPresenceCall{s: r.s, ids: userIDs}
+	return c
+}
+
+func (c *UserPresenceCall) Do() (*UserPresenceResponse, error) {
+	path := versioned("users/presence")
+	payload := struct {
+		UserIDs []string `json:"user_ids"`
+	}{c.ids}
+	ret := &UserPresenceResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
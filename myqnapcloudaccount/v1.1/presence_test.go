@@ -0,0 +1,43 @@
+package account
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_Presence(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/users/presence")
+		var body struct {
+			UserIDs []string `json:"user_ids"`
+		}
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		chk.Check(body.UserIDs, DeepEquals, []string{"u1", "u2", "u3", "u4"})
+		fmt.Fprint(w, `{"code":0,"result":{"presences":[
+			{"user_id":"u1","state":"online","last_seen":"2017-02-23T08:00:00Z"},
+			{"user_id":"u2","state":"away","last_seen":"2017-02-23T07:45:00Z"},
+			{"user_id":"u3","state":"offline","last_seen":null},
+			{"user_id":"u4","state":"private"}
+		]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.User.Presence([]string{"u1", "u2", "u3", "u4"}).Do()
+	chk.Assert(err, IsNil)
+	p := res.Result.Presences
+	chk.Assert(p, HasLen, 4)
+	chk.Check(p[0].State, Equals, PresenceOnline)
+	chk.Check(p[0].LastSeen.Equal(time.Date(2017, 2, 23, 8, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(p[1].State, Equals, PresenceAway)
+	chk.Check(p[1].LastSeen.Equal(time.Date(2017, 2, 23, 7, 45, 0, 0, time.UTC)), Equals, true)
+	chk.Check(p[2].State, Equals, PresenceOffline)
+	chk.Check(p[2].LastSeen.IsZero(), Equals, true)
+	chk.Check(p[3].UserID, Equals, "u4")
+	chk.Check(p[3].State, Equals, PresencePrivate)
+	chk.Check(p[3].LastSeen.IsZero(), Equals, true)
+}
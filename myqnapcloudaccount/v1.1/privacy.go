@@ -0,0 +1,144 @@
+package account
+
+import "encoding/json"
+
+// Visibility tells who can see a part of the profile. Values unknown to
+// this package are kept verbatim.
+type Visibility string
+
+const (
+	VisibilityPublic  Visibility = "public"
+	VisibilityFriends Visibility = "friends"
+	VisibilityPrivate Visibility = "private"
+)
+
+// PrivacySettings are the privacy settings of the account.
+type PrivacySettings struct {
+	SearchableByEmail bool       `json:"searchable_by_email"`
+	ProfileVisibility Visibility `json:"profile_visibility"`
+	ActivitySharing   Visibility `json:"activity_sharing"`
+
+	// Other holds the settings unknown to this package, so they survive a
+	// decode/encode round trip.
+	Other map[string]json.RawMessage `json:"-"`
+}
+
+type privacySettings PrivacySettings
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PrivacySettings) UnmarshalJSON(b []byte) error {
+	var other map[string]json.RawMessage
+	if err := json.Unmarshal(b, &other); err != nil {
+		return err
+	}
+	var known privacySettings
+	if err := json.Unmarshal(b, &known); err != nil {
+		return err
+	}
+	for _, k := range []string{"searchable_by_email", "profile_visibility", "activity_sharing"} {
+		delete(other, k)
+	}
+	if len(other) > 0 {
+		known.Other = other
+	}
+	*p = PrivacySettings(known)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p PrivacySettings) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(privacySettings(p))
+	if err != nil || len(p.Other) == 0 {
+		return b, err
+	}
+	m := make(map[string]json.RawMessage, len(p.Other)+3)
+	for k, v := range p.Other {
+		m[k] = v
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
+
+type PrivacySettingsResponse struct {
+	Message string          `json:"message"`
+	Code    int             `json:"code"`
+	Result  PrivacySettings `json:"result"`
+}
+
+type PrivacyService struct {
+	s *Service
+}
+
+func NewPrivacyService(s *Service) *PrivacyService {
+	rs := &PrivacyService{s: s}
+	return rs
+}
+
+type PrivacyGetCall struct {
+	s *Service
+}
+
+// Get retrieves the privacy settings of the account.
+func (r *PrivacyService) Get() *PrivacyGetCall {
+	c := &PrivacyGetCall{s: r.s}
+	return c
+}
+
+func (c *PrivacyGetCall) Do() (*PrivacySettingsResponse, error) {
+	path := versioned("me/privacy")
+	ret := &PrivacySettingsResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type PrivacyUpdateCall struct {
+	s     *Service
+	patch patch
+}
+
+// Update changes the privacy settings of the account. Only the settings
+// set on the call are sent; the others stay unchanged.
+func (r *PrivacyService) Update() *PrivacyUpdateCall {
+	c := &PrivacyUpdateCall{s: r.s, patch: patch{}}
+	return c
+}
+
+// SearchableByEmail sets whether other users can find the account by its
+// email address.
+func (c *PrivacyUpdateCall) SearchableByEmail(b bool) *PrivacyUpdateCall {
+	c.patch["searchable_by_email"] = b
+	return c
+}
+
+// ProfileVisibility sets who can see the profile.
+func (c *PrivacyUpdateCall) ProfileVisibility(v Visibility) *PrivacyUpdateCall {
+	c.patch["profile_visibility"] = v
+	return c
+}
+
+// ActivitySharing sets who can see the account activity.
+func (c *PrivacyUpdateCall) ActivitySharing(v Visibility) *PrivacyUpdateCall {
+	c.patch["activity_sharing"] = v
+	return c
+}
+
+// Set sets any setting, including ones unknown to this package.
+func (c *PrivacyUpdateCall) Set(key string, value interface{}) *PrivacyUpdateCall {
+	c.patch[key] = value
+	return c
+}
+
+func (c *PrivacyUpdateCall) Do() (*PrivacySettingsResponse, error) {
+	path := versioned("me/privacy")
+	ret := &PrivacySettingsResponse{}
+	_, err := c.s.patch(path, c.patch, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,61 @@
+package account
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+const privacyFixture = `{"code":0,"result":{
+	"searchable_by_email":true,
+	"profile_visibility":"friends",
+	"activity_sharing":"private",
+	"show_online_status":false,
+	"discoverable_devices":{"nas":true}
+}}`
+
+func (s *MySuite) Test_Myqnapcloud_Account_Privacy_Get(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/privacy")
+		fmt.Fprint(w, privacyFixture)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Privacy.Get().Do()
+	chk.Assert(err, IsNil)
+	p := res.Result
+	chk.Check(p.SearchableByEmail, Equals, true)
+	chk.Check(p.ProfileVisibility, Equals, VisibilityFriends)
+	chk.Check(p.ActivitySharing, Equals, VisibilityPrivate)
+	chk.Assert(p.Other, HasLen, 2)
+	chk.Check(string(p.Other["show_online_status"]), Equals, "false")
+
+	b, err := json.Marshal(p)
+	chk.Assert(err, IsNil)
+	var got, want map[string]interface{}
+	chk.Assert(json.Unmarshal(b, &got), IsNil)
+	chk.Assert(json.Unmarshal([]byte(privacyFixture), &want), IsNil)
+	chk.Check(got, DeepEquals, want["result"])
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Privacy_Update(chk *C) {
+	var body string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "PATCH")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/privacy")
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		fmt.Fprint(w, privacyFixture)
+	})
+	defer ts.Close()
+
+	_, err := svc.Me.Privacy.Update().
+		SearchableByEmail(false).
+		Set("show_online_status", true).
+		Do()
+	chk.Assert(err, IsNil)
+	chk.Check(body, Equals, `{"searchable_by_email":false,"show_online_status":true}`+"\n")
+}
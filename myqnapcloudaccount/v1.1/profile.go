@@ -0,0 +1,213 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Gender is the gender of the account, encoded as an integer on the wire.
+// Values unknown to this package decode without error; int(g) gives the
+// raw value.
+type Gender int
+
+const (
+	GenderUnspecified Gender = 0
+	GenderMale        Gender = 1
+	GenderFemale      Gender = 2
+	GenderOther       Gender = 3
+)
+
+// IsKnown reports whether g is one of the documented values.
+func (g Gender) IsKnown() bool {
+	return g >= GenderUnspecified && g <= GenderOther
+}
+
+// String implements fmt.Stringer.
+func (g Gender) String() string {
+	switch g {
+	case GenderUnspecified:
+		return "unspecified"
+	case GenderMale:
+		return "male"
+	case GenderFemale:
+		return "female"
+	case GenderOther:
+		return "other"
+	}
+	return fmt.Sprintf("Gender(%d)", int(g))
+}
+
+// Location returns the timezone of the account, or UTC when none is set.
+func (r *GetUserResponse) Location() (*time.Location, error) {
+	if r.Result.Timezone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(r.Result.Timezone)
+}
+
+// InAccountZone converts t, such as an activity timestamp, to the timezone
+// of the account.
+func (r *GetUserResponse) InAccountZone(t time.Time) (time.Time, error) {
+	loc, err := r.Location()
+	if err != nil {
+		return t, err
+	}
+	return t.In(loc), nil
+}
+
+type MeUpdateCall struct {
+	s     *Service
+	patch patch
+	err   error
+}
+
+// Update changes the profile of the account. Only the fields set on the
+// call are sent; the others stay unchanged.
+func (r *MeService) Update() *MeUpdateCall {
+	c := &MeUpdateCall{s: r.s, patch: patch{}}
+	return c
+}
+
+// fail records the first validation error, returned by Do.
+func (c *MeUpdateCall) fail(err error) *MeUpdateCall {
+	if c.err == nil {
+		c.err = err
+	}
+	return c
+}
+
+// FirstName sets the first name.
+func (c *MeUpdateCall) FirstName(name string) *MeUpdateCall {
+	c.patch["first_name"] = name
+	return c
+}
+
+// LastName sets the last name.
+func (c *MeUpdateCall) LastName(name string) *MeUpdateCall {
+	c.patch["last_name"] = name
+	return c
+}
+
+// DisplayName sets the display name. See CheckDisplayName for the rules
+// it must follow.
+func (c *MeUpdateCall) DisplayName(name string) *MeUpdateCall {
+	if err := validateDisplayName(name); err != nil {
+		return c.fail(err)
+	}
+	c.patch["display_name"] = name
+	return c
+}
+
+// Language sets the preferred language, as a language tag such as "en-US".
+// Once the supported languages have been fetched with User.Languages, lang
+// must be one of them.
+func (c *MeUpdateCall) Language(lang string) *MeUpdateCall {
+	if c.s.User != nil {
+		if list := c.s.User.languages.get(); list != nil && !supportsLanguage(list, lang) {
+			return c.fail(fmt.Errorf("account: unsupported language %q", lang))
+		}
+	}
+	c.patch["language"] = lang
+	return c
+}
+
+// Gender sets the gender. Only the documented values are accepted.
+func (c *MeUpdateCall) Gender(g Gender) *MeUpdateCall {
+	if !g.IsKnown() {
+		return c.fail(fmt.Errorf("account: invalid gender %d", int(g)))
+	}
+	c.patch["gender"] = g
+	return c
+}
+
+// MinimumAge is the minimum age, in years, accepted by Birthday.
+const MinimumAge = 13
+
+// now returns the current time; tests replace it.
+var now = time.Now
+
+// Birthday sets the date of birth. It must not be in the future, and the
+// account holder must be at least MinimumAge years old.
+func (c *MeUpdateCall) Birthday(d Date) *MeUpdateCall {
+	if d.IsZero() {
+		return c.fail(errors.New("account: birthday is not set"))
+	}
+	today := DateOf(now())
+	if today.Before(d) {
+		return c.fail(fmt.Errorf("account: birthday %s is in the future", d))
+	}
+	if latest := DateOf(today.In(time.UTC).AddDate(-MinimumAge, 0, 0)); latest.Before(d) {
+		return c.fail(fmt.Errorf("account: birthday %s is under the minimum age of %d", d, MinimumAge))
+	}
+	c.patch["brithday"] = d
+	return c
+}
+
+// Country sets the country or region, as an ISO 3166-1 alpha-2 code.
+func (c *MeUpdateCall) Country(code string) *MeUpdateCall {
+	code = strings.ToUpper(code)
+	if !IsCountryCode(code) {
+		return c.fail(fmt.Errorf("account: invalid country code %q", code))
+	}
+	c.patch["country"] = code
+	return c
+}
+
+// Timezone sets the account timezone, as an IANA name such as
+// "Asia/Taipei". Names unknown to the local timezone database are
+// rejected.
+func (c *MeUpdateCall) Timezone(tz string) *MeUpdateCall {
+	if tz == "" || tz == "Local" {
+		return c.fail(errors.New("account: timezone must be an IANA name"))
+	}
+	if _, err := time.LoadLocation(tz); err != nil {
+		return c.fail(fmt.Errorf("account: invalid timezone %q: %v", tz, err))
+	}
+	c.patch["timezone"] = tz
+	return c
+}
+
+func (c *MeUpdateCall) Do() (*GetUserResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	path := versioned("me")
+	ret := &GetUserResponse{}
+	_, err := c.s.patch(path, c.patch, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type PortalNotifyResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		PortalNotify bool `json:"portal_notify"`
+	} `json:"result"`
+}
+
+type MeSetPortalNotifyCall struct {
+	s     *Service
+	patch patch
+}
+
+// SetPortalNotify sets whether the account receives portal notifications.
+// Setting the current value again succeeds without changing anything.
+func (r *MeService) SetPortalNotify(notify bool) *MeSetPortalNotifyCall {
+	c := &MeSetPortalNotifyCall{s: r.s, patch: patch{"portal_notify": notify}}
+	return c
+}
+
+func (c *MeSetPortalNotifyCall) Do() (*PortalNotifyResponse, error) {
+	path := versioned("me")
+	ret := &PortalNotifyResponse{}
+	_, err := c.s.put(path, c.patch, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,206 @@
+package account
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Me_Update_Timezone(chk *C) {
+	var body string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "PATCH")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me")
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		fmt.Fprint(w, `{"code":0,"result":{"user_id":"u1","timezone":"Asia/Taipei"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Update().Timezone("Asia/Taipei").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(body, Equals, `{"timezone":"Asia/Taipei"}`+"\n")
+	chk.Check(res.Result.Timezone, Equals, "Asia/Taipei")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Me_Update_InvalidTimezone(chk *C) {
+	called := false
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+	defer ts.Close()
+
+	for _, tz := range []string{"Mars/Olympus_Mons", "", "Local"} {
+		_, err := svc.Me.Update().DisplayName("Alice").Timezone(tz).Do()
+		chk.Check(err, ErrorMatches, "account: .*timezone.*", Commentf("%q", tz))
+	}
+	chk.Check(called, Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Me_InAccountZone(chk *C) {
+	me := &GetUserResponse{}
+	t := time.Date(2017, 3, 1, 16, 30, 0, 0, time.UTC)
+
+	got, err := me.InAccountZone(t)
+	chk.Assert(err, IsNil)
+	chk.Check(got.Location(), Equals, time.UTC)
+
+	me.Result.Timezone = "Asia/Taipei"
+	got, err = me.InAccountZone(t)
+	chk.Assert(err, IsNil)
+	chk.Check(got.Equal(t), Equals, true)
+	chk.Check(got.Format("2006-01-02 15:04 MST"), Equals, "2017-03-02 00:30 CST")
+
+	me.Result.Timezone = "Nowhere/Special"
+	_, err = me.InAccountZone(t)
+	chk.Check(err, NotNil)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Gender_RoundTrip(chk *C) {
+	names := map[Gender]string{
+		GenderUnspecified: "unspecified",
+		GenderMale:        "male",
+		GenderFemale:      "female",
+		GenderOther:       "other",
+	}
+	for g, name := range names {
+		chk.Check(g.String(), Equals, name)
+		chk.Check(g.IsKnown(), Equals, true)
+
+		me := &GetUserResponse{}
+		fixture := fmt.Sprintf(`{"result":{"gender":%d}}`, int(g))
+		chk.Assert(json.Unmarshal([]byte(fixture), me), IsNil)
+		chk.Check(me.Result.Gender, Equals, g)
+
+		b, err := json.Marshal(g)
+		chk.Assert(err, IsNil)
+		chk.Check(string(b), Equals, fmt.Sprint(int(g)))
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Gender_Unknown(chk *C) {
+	me := &GetUserResponse{}
+	chk.Assert(json.Unmarshal([]byte(`{"result":{"gender":9}}`), me), IsNil)
+	g := me.Result.Gender
+	chk.Check(int(g), Equals, 9)
+	chk.Check(g.IsKnown(), Equals, false)
+	chk.Check(g.String(), Equals, "Gender(9)")
+
+	b, err := json.Marshal(g)
+	chk.Assert(err, IsNil)
+	chk.Check(string(b), Equals, "9")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Me_Update_Gender(chk *C) {
+	var body string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		fmt.Fprint(w, `{"code":0,"result":{"gender":2}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Update().Gender(GenderFemale).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(body, Equals, `{"gender":2}`+"\n")
+	chk.Check(res.Result.Gender, Equals, GenderFemale)
+
+	body = ""
+	_, err = svc.Me.Update().Gender(Gender(7)).Do()
+	chk.Check(err, ErrorMatches, "account: invalid gender 7")
+	chk.Check(body, Equals, "")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Me_Birthday(chk *C) {
+	me := &GetUserResponse{}
+	chk.Assert(json.Unmarshal([]byte(`{"result":{"brithday":"1985-07-09"}}`), me), IsNil)
+	chk.Check(me.Result.Birthday, Equals, Date{1985, time.July, 9})
+
+	me = &GetUserResponse{}
+	chk.Assert(json.Unmarshal([]byte(`{"result":{"brithday":""}}`), me), IsNil)
+	chk.Check(me.Result.Birthday.IsZero(), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Me_Update_Birthday(chk *C) {
+	defer func(f func() time.Time) { now = f }(now)
+	now = func() time.Time { return time.Date(2017, 2, 23, 8, 0, 0, 0, time.UTC) }
+
+	var body string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		fmt.Fprint(w, `{"code":0,"result":{"brithday":"2004-02-23"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Update().Birthday(Date{2004, time.February, 23}).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(body, Equals, `{"brithday":"2004-02-23"}`+"\n")
+	chk.Check(res.Result.Birthday, Equals, Date{2004, time.February, 23})
+
+	body = ""
+	for _, d := range []Date{{2017, time.February, 24}, {2004, time.February, 24}, {}} {
+		_, err = svc.Me.Update().Birthday(d).Do()
+		chk.Check(err, NotNil, Commentf("%v", d))
+	}
+	_, err = svc.Me.Update().Birthday(Date{2018, time.January, 1}).Do()
+	chk.Check(err, ErrorMatches, ".*in the future")
+	_, err = svc.Me.Update().Birthday(Date{2010, time.January, 1}).Do()
+	chk.Check(err, ErrorMatches, ".*minimum age.*")
+	chk.Check(body, Equals, "")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Me_SetPortalNotify(chk *C) {
+	var body string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "PUT")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me")
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		var p struct {
+			PortalNotify bool `json:"portal_notify"`
+		}
+		json.Unmarshal(b, &p)
+		fmt.Fprintf(w, `{"code":0,"result":{"first_name":"Qeek","portal_notify":%t}}`, p.PortalNotify)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.SetPortalNotify(true).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(body, Equals, `{"portal_notify":true}`+"\n")
+	chk.Check(res.Result.PortalNotify, Equals, true)
+
+	res, err = svc.Me.SetPortalNotify(false).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(body, Equals, `{"portal_notify":false}`+"\n")
+	chk.Check(res.Result.PortalNotify, Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Me_SetPortalNotify_Unchanged(chk *C) {
+	notify, writes := true, 0
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "PUT")
+		var p struct {
+			PortalNotify bool `json:"portal_notify"`
+		}
+		chk.Check(json.NewDecoder(r.Body).Decode(&p), IsNil)
+		if p.PortalNotify != notify {
+			notify = p.PortalNotify
+			writes++
+		}
+		fmt.Fprintf(w, `{"code":0,"result":{"portal_notify":%t}}`, notify)
+	})
+	defer ts.Close()
+
+	// Re-sending the current value succeeds and leaves it as it is.
+	for i := 0; i < 2; i++ {
+		res, err := svc.Me.SetPortalNotify(true).Do()
+		chk.Assert(err, IsNil)
+		chk.Check(res.Result.PortalNotify, Equals, true)
+	}
+	chk.Check(writes, Equals, 0)
+}
@@ -0,0 +1,129 @@
+package account
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Platform is the push notification service of a mobile device.
+type Platform string
+
+const (
+	PlatformFCM  Platform = "fcm"  // Firebase Cloud Messaging, for Android
+	PlatformAPNs Platform = "apns" // Apple Push Notification service
+)
+
+// A PushRegistration is a mobile device receiving push notifications for
+// the account. The push token itself is never returned.
+type PushRegistration struct {
+	ID         string    `json:"registration_id"`
+	Platform   Platform  `json:"platform"`
+	DeviceName string    `json:"device_name"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type PushTokensService struct {
+	s *Service
+}
+
+func NewPushTokensService(s *Service) *PushTokensService {
+	rs := &PushTokensService{s: s}
+	return rs
+}
+
+type pushTokenRequest struct {
+	Platform   Platform `json:"platform"`
+	Token      string   `json:"token"`
+	DeviceName string   `json:"device_name"`
+}
+
+func (r pushTokenRequest) redacted() interface{} {
+	r.Token = redactedValue
+	return r
+}
+
+type PushTokenRegisterResponse struct {
+	Message string           `json:"message"`
+	Code    int              `json:"code"`
+	Result  PushRegistration `json:"result"`
+
+	// Created is set when the token was registered for the first time,
+	// and unset when an existing registration of the token was updated.
+	Created bool `json:"-"`
+}
+
+type PushTokensRegisterCall struct {
+	s   *Service
+	req pushTokenRequest
+}
+
+// Register registers the push token of a mobile device, so that it
+// receives the notifications of the account. Registering a token again
+// updates its registration.
+func (r *PushTokensService) Register(platform Platform, token string, deviceName string) *PushTokensRegisterCall {
+	c := &PushTokensRegisterCall{s: r.s, req: pushTokenRequest{platform, token, deviceName}}
+	return c
+}
+
+func (c *PushTokensRegisterCall) Do() (*PushTokenRegisterResponse, error) {
+	if strings.TrimSpace(c.req.Token) == "" {
+		return nil, errors.New("account: push token is empty")
+	}
+	path := versioned("me/push-tokens")
+	ret := &PushTokenRegisterResponse{}
+	resp, err := c.s.post(path, c.req, ret)
+	if err != nil {
+		return nil, err
+	}
+	ret.Created = resp.StatusCode == http.StatusCreated
+	return ret, nil
+}
+
+type PushTokenListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Registrations []*PushRegistration `json:"registrations"`
+	} `json:"result"`
+}
+
+type PushTokensListCall struct {
+	s *Service
+}
+
+// List lists the mobile devices registered for push notifications.
+func (r *PushTokensService) List() *PushTokensListCall {
+	c := &PushTokensListCall{s: r.s}
+	return c
+}
+
+func (c *PushTokensListCall) Do() (*PushTokenListResponse, error) {
+	path := versioned("me/push-tokens")
+	ret := &PushTokenListResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type PushTokensUnregisterCall struct {
+	s  *Service
+	id string
+}
+
+// Unregister stops sending push notifications to a mobile device.
+func (r *PushTokensService) Unregister(registrationID string) *PushTokensUnregisterCall {
+	c := &PushTokensUnregisterCall{s: r.s, id: registrationID}
+	return c
+}
+
+func (c *PushTokensUnregisterCall) Do() error {
+	path := versioned("me/push-tokens/" + url.PathEscape(c.id))
+	_, err := c.s.delete(path, nil, nil)
+	return err
+}
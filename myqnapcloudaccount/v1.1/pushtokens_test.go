@@ -0,0 +1,92 @@
+package account
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+// fakePushTokensAPI keeps the push registrations of one account in
+// memory, by token.
+func fakePushTokensAPI(chk *C) http.HandlerFunc {
+	type registration struct {
+		ID         string `json:"registration_id"`
+		Platform   string `json:"platform"`
+		DeviceName string `json:"device_name"`
+	}
+	regs := map[string]*registration{}
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/v1.1/me/push-tokens":
+			var body map[string]string
+			chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+			reg, ok := regs[body["token"]]
+			if !ok {
+				reg = &registration{ID: fmt.Sprintf("pr-%d", len(regs)+1)}
+				regs[body["token"]] = reg
+				w.WriteHeader(http.StatusCreated)
+			}
+			reg.Platform, reg.DeviceName = body["platform"], body["device_name"]
+			json.NewEncoder(w).Encode(map[string]interface{}{"code": 0, "result": reg})
+		case r.Method == "GET" && r.URL.Path == "/v1.1/me/push-tokens":
+			var list []*registration
+			for _, reg := range regs {
+				list = append(list, reg)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"code": 0, "result": map[string]interface{}{"registrations": list}})
+		case r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/v1.1/me/push-tokens/"):
+			for token, reg := range regs {
+				if "/v1.1/me/push-tokens/"+reg.ID == r.URL.Path {
+					delete(regs, token)
+				}
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			chk.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_PushTokens(chk *C) {
+	svc, ts := newFakeService(fakePushTokensAPI(chk))
+	defer ts.Close()
+
+	logs := &bytes.Buffer{}
+	log.SetOutput(logs)
+	defer log.SetOutput(os.Stderr)
+	svc.Debug = true
+
+	res, err := svc.Me.PushTokens.Register(PlatformFCM, "fcm-token-abc123", "Pixel").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Created, Equals, true)
+	chk.Check(res.Result.ID, Equals, "pr-1")
+
+	res, err = svc.Me.PushTokens.Register(PlatformFCM, "fcm-token-abc123", "Pixel 2").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Created, Equals, false)
+	chk.Check(res.Result.ID, Equals, "pr-1")
+	chk.Check(res.Result.DeviceName, Equals, "Pixel 2")
+
+	list, err := svc.Me.PushTokens.List().Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(list.Result.Registrations, HasLen, 1)
+	chk.Check(list.Result.Registrations[0].Platform, Equals, PlatformFCM)
+	chk.Check(list.Result.Registrations[0].DeviceName, Equals, "Pixel 2")
+
+	chk.Assert(svc.Me.PushTokens.Unregister("pr-1").Do(), IsNil)
+	list, err = svc.Me.PushTokens.List().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(list.Result.Registrations, HasLen, 0)
+
+	chk.Check(strings.Contains(logs.String(), "fcm-token-abc123"), Equals, false)
+	chk.Check(strings.Contains(logs.String(), redactedValue), Equals, true)
+
+	_, err = svc.Me.PushTokens.Register(PlatformAPNs, " ", "iPhone").Do()
+	chk.Check(err, ErrorMatches, "account: push token is empty")
+}
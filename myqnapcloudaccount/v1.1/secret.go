@@ -0,0 +1,19 @@
+package account
+
+// A Secret is a credential returned by the API. It is encoded to JSON
+// as is, but never printed by the fmt package, so responses holding one
+// can be logged safely. Convert it to a string to use it.
+type Secret string
+
+// String implements fmt.Stringer.
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redactedValue
+}
+
+// GoString implements fmt.GoStringer.
+func (s Secret) GoString() string {
+	return `"` + s.String() + `"`
+}
@@ -0,0 +1,23 @@
+package account
+
+import (
+	"encoding/json"
+	"fmt"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Secret(chk *C) {
+	v := struct {
+		Name  string `json:"name"`
+		Token Secret `json:"token"`
+	}{"key", "hunter2"}
+
+	chk.Check(fmt.Sprintf("%v %+v %#v %s", v, v, v, v.Token), Not(Matches), "(?s).*hunter2.*")
+	chk.Check(string(v.Token), Equals, "hunter2")
+
+	b, err := json.Marshal(v)
+	chk.Assert(err, IsNil)
+	chk.Check(string(b), Equals, `{"name":"key","token":"hunter2"}`)
+	chk.Check(Secret("").String(), Equals, "")
+}
@@ -0,0 +1,71 @@
+package account
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+const securityEventsFixture = `{"code":0,"result":{"events":[
+	{"id":"e1","type":"new_device_login","risk_level":"medium","risk_score":40,"ip":"203.0.113.7",
+	 "location":"Osaka, JP","device":"Firefox on Linux","notified":true,"created_at":"2017-02-20T01:00:00Z"},
+	{"id":"e2","type":"password_changed","risk_level":"low","risk_score":5,"notified":true,
+	 "acknowledged":true,"acknowledged_at":"2017-02-19T12:00:00Z","created_at":"2017-02-19T11:00:00Z"},
+	{"id":"e3","type":"two_factor_disabled","risk_level":"high","risk_score":80,"created_at":"2017-02-18T00:00:00Z"},
+	{"id":"e4","type":"email_changed","risk_level":"high","risk_score":75,"created_at":"2017-02-17T00:00:00Z"},
+	{"id":"e5","type":"suspicious_login","risk_level":"critical","risk_score":99,"created_at":"2017-02-16T00:00:00Z"},
+	{"id":"e6","type":"suspicious_login","risk_level":"elevated","risk_score":60,"created_at":"2017-02-15T00:00:00Z"}
+],"next_page_token":"s2"}}`
+
+func (s *MySuite) Test_Myqnapcloud_Account_SecurityEvents_List(chk *C) {
+	var query string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/security-events")
+		query = r.URL.RawQuery
+		fmt.Fprint(w, securityEventsFixture)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.SecurityEvents.List().
+		CreatedAfter(time.Date(2017, 2, 1, 0, 0, 0, 0, time.UTC)).
+		PageSize(10).
+		Do()
+	chk.Assert(err, IsNil)
+	chk.Check(query, Equals, "created_after=2017-02-01T00%3A00%3A00Z&page_size=10")
+	chk.Check(res.Result.NextPageToken, Equals, "s2")
+
+	ev := res.Result.Events
+	chk.Assert(ev, HasLen, 6)
+	wantTypes := []SecurityEventType{
+		SecurityNewDeviceLogin, SecurityPasswordChanged, SecurityTwoFactorDisabled,
+		SecurityEmailChanged, SecuritySuspiciousLogin, SecuritySuspiciousLogin,
+	}
+	wantRisk := []RiskLevel{RiskMedium, RiskLow, RiskHigh, RiskHigh, RiskCritical, "elevated"}
+	for i, e := range ev {
+		chk.Check(e.Type, Equals, wantTypes[i])
+		chk.Check(e.RiskLevel, Equals, wantRisk[i])
+	}
+	chk.Check(ev[0].Notified, Equals, true)
+	chk.Check(ev[0].RiskScore, Equals, 40)
+	chk.Check(ev[1].Acknowledged, Equals, true)
+	chk.Check(ev[2].Notified, Equals, false)
+	chk.Check(ev[5].RiskLevel.IsKnown(), Equals, false)
+	chk.Check(ev[4].RiskLevel.IsKnown(), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_SecurityEvents_Acknowledge(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/security-events/e1/acknowledge")
+		fmt.Fprint(w, `{"code":0,"result":{"id":"e1","type":"new_device_login","risk_level":"medium",
+			"acknowledged":true,"acknowledged_at":"2017-02-23T08:00:00Z"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.SecurityEvents.Acknowledge("e1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Acknowledged, Equals, true)
+	chk.Check(res.Result.AcknowledgedAt.Equal(time.Date(2017, 2, 23, 8, 0, 0, 0, time.UTC)), Equals, true)
+}
@@ -0,0 +1,169 @@
+package account
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// A Session is a signed-in session of the current account.
+type Session struct {
+	ID         string    `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	IP         string    `json:"ip"`
+	Location   string    `json:"location"`
+	Device     string    `json:"device"`
+
+	// Current is set on the session the request was made with.
+	Current bool `json:"current"`
+}
+
+type SessionListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Sessions      []*Session `json:"sessions"`
+		NextPageToken string     `json:"next_page_token"`
+	} `json:"result"`
+}
+
+type SessionsService struct {
+	s *Service
+}
+
+func NewSessionsService(s *Service) *SessionsService {
+	rs := &SessionsService{s: s}
+	return rs
+}
+
+type SessionListCall struct {
+	s      *Service
+	params url.Values
+}
+
+// List lists the active sessions of the current account.
+func (r *SessionsService) List() *SessionListCall {
+	c := &SessionListCall{s: r.s, params: url.Values{}}
+	return c
+}
+
+// PageSize sets the maximum number of sessions returned per page.
+func (c *SessionListCall) PageSize(n int) *SessionListCall {
+	c.params.Set("page_size", strconv.Itoa(n))
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *SessionListCall) PageToken(token string) *SessionListCall {
+	c.params.Set("page_token", token)
+	return c
+}
+
+func (c *SessionListCall) Do() (*SessionListResponse, error) {
+	path := versioned("me/sessions")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &SessionListResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ErrCurrentSession is returned when a call would revoke the session it is
+// made with without AllowCurrent.
+var ErrCurrentSession = errors.New("account: revoking the current session requires AllowCurrent()")
+
+const codeCurrentSession = 40901
+
+type SessionRevokeResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Revoked int `json:"revoked"`
+	} `json:"result"`
+}
+
+type SessionRevokeCall struct {
+	s      *Service
+	id     string
+	params url.Values
+}
+
+// Revoke signs out a session. Sessions that already expired are reported
+// as revoked.
+func (r *SessionsService) Revoke(sessionID string) *SessionRevokeCall {
+	c := &SessionRevokeCall{s: r.s, id: sessionID, params: url.Values{}}
+	return c
+}
+
+// AllowCurrent allows revoking the session the call is made with, which
+// invalidates the credentials of this Service.
+func (c *SessionRevokeCall) AllowCurrent() *SessionRevokeCall {
+	c.params.Set("allow_current", "true")
+	return c
+}
+
+func (c *SessionRevokeCall) Do() (*SessionRevokeResponse, error) {
+	path := versioned("me/sessions/" + url.PathEscape(c.id))
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &SessionRevokeResponse{}
+	resp, err := c.s.delete(path, nil, ret)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) || resp != nil && resp.StatusCode == http.StatusGone {
+			return &SessionRevokeResponse{}, nil
+		}
+		return nil, err
+	}
+	return ret, nil
+}
+
+type SessionRevokeAllCall struct {
+	s             *Service
+	exceptCurrent bool
+	allowCurrent  bool
+}
+
+// RevokeAll signs out every session of the current account except, by
+// default, the one the call is made with.
+func (r *SessionsService) RevokeAll() *SessionRevokeAllCall {
+	c := &SessionRevokeAllCall{s: r.s, exceptCurrent: true}
+	return c
+}
+
+// ExceptCurrent sets whether the current session is kept. Revoking it too
+// also requires AllowCurrent.
+func (c *SessionRevokeAllCall) ExceptCurrent(b bool) *SessionRevokeAllCall {
+	c.exceptCurrent = b
+	return c
+}
+
+// AllowCurrent allows revoking the session the call is made with, which
+// invalidates the credentials of this Service.
+func (c *SessionRevokeAllCall) AllowCurrent() *SessionRevokeAllCall {
+	c.allowCurrent = true
+	return c
+}
+
+func (c *SessionRevokeAllCall) Do() (*SessionRevokeResponse, error) {
+	if !c.exceptCurrent && !c.allowCurrent {
+		return nil, ErrCurrentSession
+	}
+	params := url.Values{}
+	params.Set("except_current", strconv.FormatBool(c.exceptCurrent))
+	path := versioned("me/sessions") + "?" + params.Encode()
+	ret := &SessionRevokeResponse{}
+	_, err := c.s.delete(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
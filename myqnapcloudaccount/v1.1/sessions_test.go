@@ -0,0 +1,106 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_Sessions_List(chk *C) {
+	var query string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/sessions")
+		query = r.URL.RawQuery
+		fmt.Fprint(w, `{"code":0,"result":{"sessions":[
+			{"id":"s1","created_at":"2017-02-01T08:00:00Z","last_seen_at":"2017-02-23T07:00:00Z",
+			 "ip":"203.0.113.7","location":"Taipei, TW","device":"Chrome on Windows","current":true},
+			{"id":"s2","created_at":"2017-01-15T12:30:00+08:00","last_seen_at":"2017-02-20T09:15:00+08:00",
+			 "ip":"198.51.100.2","location":"Tokyo, JP","device":"Qfile on iOS"}
+		],"next_page_token":"n2"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Sessions.List().PageSize(2).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(query, Equals, "page_size=2")
+	chk.Check(res.Result.NextPageToken, Equals, "n2")
+	chk.Assert(res.Result.Sessions, HasLen, 2)
+
+	cur, other := res.Result.Sessions[0], res.Result.Sessions[1]
+	chk.Check(cur.Current, Equals, true)
+	chk.Check(cur.LastSeenAt.Equal(time.Date(2017, 2, 23, 7, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(other.Current, Equals, false)
+	chk.Check(other.ID, Equals, "s2")
+	chk.Check(other.CreatedAt.Equal(time.Date(2017, 1, 15, 4, 30, 0, 0, time.UTC)), Equals, true)
+	chk.Check(other.Device, Equals, "Qfile on iOS")
+	chk.Check(other.Location, Equals, "Tokyo, JP")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Sessions_Revoke(chk *C) {
+	var path, query string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "DELETE")
+		path, query = r.URL.EscapedPath(), r.URL.RawQuery
+		if r.URL.Path == "/v1.1/me/sessions/current" && query == "" {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"code":40901,"message":"cannot revoke current session"}`)
+			return
+		}
+		fmt.Fprint(w, `{"code":0,"result":{"revoked":1}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.Sessions.Revoke("s 2").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(path, Equals, "/v1.1/me/sessions/s%202")
+	chk.Check(res.Result.Revoked, Equals, 1)
+
+	_, err = svc.Me.Sessions.Revoke("current").Do()
+	chk.Check(errors.Is(err, ErrCurrentSession), Equals, true)
+
+	_, err = svc.Me.Sessions.Revoke("current").AllowCurrent().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(query, Equals, "allow_current=true")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Sessions_Revoke_AlreadyGone(chk *C) {
+	for _, status := range []int{http.StatusNotFound, http.StatusGone} {
+		svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			fmt.Fprint(w, `{"code":404,"message":"session expired"}`)
+		})
+
+		res, err := svc.Me.Sessions.Revoke("s9").Do()
+		chk.Check(err, IsNil, Commentf("status %d", status))
+		chk.Check(res, NotNil)
+		ts.Close()
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Sessions_RevokeAll(chk *C) {
+	var query string
+	calls := 0
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		chk.Check(r.Method, Equals, "DELETE")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/sessions")
+		query = r.URL.RawQuery
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer ts.Close()
+
+	_, err := svc.Me.Sessions.RevokeAll().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(query, Equals, "except_current=true")
+
+	_, err = svc.Me.Sessions.RevokeAll().ExceptCurrent(false).Do()
+	chk.Check(err, Equals, ErrCurrentSession)
+	chk.Check(calls, Equals, 1)
+
+	_, err = svc.Me.Sessions.RevokeAll().ExceptCurrent(false).AllowCurrent().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(query, Equals, "except_current=false")
+}
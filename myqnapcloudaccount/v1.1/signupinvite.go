@@ -0,0 +1,75 @@
+package account
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+const codeAlreadyRegistered = 40914
+
+// maxSignupMessage is the longest message accepted by InviteSignup, in
+// characters.
+const maxSignupMessage = 500
+
+type SignupInvitationResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		InvitationID string    `json:"invitation_id"`
+		ExpiresAt    time.Time `json:"expires_at"`
+
+		// UserID is the existing user, when AlreadyRegistered is set.
+		UserID string `json:"user_id"`
+	} `json:"result"`
+
+	// AlreadyRegistered is set when the email address belongs to a user
+	// already; no invitation is sent then, and Result.UserID can be
+	// invited with Friend.Invite instead.
+	AlreadyRegistered bool `json:"-"`
+}
+
+type UserInviteSignupCall struct {
+	s       *Service
+	email   string
+	message string
+}
+
+// InviteSignup invites the owner of an email address with no account to
+// sign up. They become friends with the account once signed up. message
+// is optional. When the address was invited too recently, the returned
+// error matches ErrResendCooldown; see ResendCooldown.
+func (r *UserService) InviteSignup(email string, message string) *UserInviteSignupCall {
+	c := &UserInviteSignupCall{s: r.s, email: email, message: message}
+	return c
+}
+
+func (c *UserInviteSignupCall) Do() (*SignupInvitationResponse, error) {
+	if err := validateEmail(c.email); err != nil {
+		return nil, err
+	}
+	if utf8.RuneCountInString(c.message) > maxSignupMessage {
+		return nil, fmt.Errorf("account: signup invitation message must be at most %d characters long", maxSignupMessage)
+	}
+	path := versioned("users/signup-invitations")
+	payload := struct {
+		Email   string `json:"email"`
+		Message string `json:"message,omitempty"`
+	}{c.email, c.message}
+	ret := &SignupInvitationResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		var e *ErrorResponse
+		if errors.As(err, &e) && e.Code == codeAlreadyRegistered {
+			ret = &SignupInvitationResponse{Message: e.Message, Code: e.Code, AlreadyRegistered: true}
+			if len(e.Details) > 0 {
+				json.Unmarshal(e.Details, &ret.Result)
+			}
+			return ret, nil
+		}
+		return nil, err
+	}
+	return ret, nil
+}
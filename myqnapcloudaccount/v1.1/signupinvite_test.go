@@ -0,0 +1,63 @@
+package account
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_InviteSignup(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/users/signup-invitations")
+		var body map[string]string
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		switch body["email"] {
+		case "new@example.com":
+			chk.Check(body["message"], Equals, "Join my NAS")
+			fmt.Fprint(w, `{"code":0,"result":{"invitation_id":"si-1","expires_at":"2017-03-02T08:00:00Z"}}`)
+		case "known@example.com":
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"code":40914,"message":"already registered","details":{"user_id":"u42"}}`)
+		default:
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"code":42901,"message":"invited too soon","details":{"retry_after_seconds":86400}}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.User.InviteSignup("new@example.com", "Join my NAS").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.AlreadyRegistered, Equals, false)
+	chk.Check(res.Result.InvitationID, Equals, "si-1")
+	chk.Check(res.Result.ExpiresAt.Equal(time.Date(2017, 3, 2, 8, 0, 0, 0, time.UTC)), Equals, true)
+
+	res, err = svc.User.InviteSignup("known@example.com", "").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.AlreadyRegistered, Equals, true)
+	chk.Check(res.Result.UserID, Equals, "u42")
+	chk.Check(res.Result.InvitationID, Equals, "")
+
+	_, err = svc.User.InviteSignup("again@example.com", "").Do()
+	chk.Check(errors.Is(err, ErrResendCooldown), Equals, true)
+	d, ok := ResendCooldown(err)
+	chk.Check(ok, Equals, true)
+	chk.Check(d, Equals, 24*time.Hour)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_InviteSignup_Invalid(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Error("unexpected request")
+	})
+	defer ts.Close()
+
+	_, err := svc.User.InviteSignup("Bob <bob@example.com>", "").Do()
+	chk.Check(err, ErrorMatches, `account: invalid email address "Bob <bob@example.com>"`)
+	_, err = svc.User.InviteSignup("bob@example.com", strings.Repeat("x", 501)).Do()
+	chk.Check(err, ErrorMatches, "account: signup invitation message must be at most 500 characters long")
+}
@@ -0,0 +1,65 @@
+package account
+
+import "time"
+
+type SimpleTokenService struct {
+	s *Service
+}
+
+func NewSimpleTokenService(s *Service) *SimpleTokenService {
+	rs := &SimpleTokenService{s: s}
+	return rs
+}
+
+type SimpleTokenResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// SimpleToken is the new token. It is only ever returned here,
+		// and is empty once revoked.
+		SimpleToken Secret    `json:"simple_token"`
+		CreatedAt   time.Time `json:"created_at"`
+	} `json:"result"`
+}
+
+type SimpleTokenRegenerateCall struct {
+	s *Service
+}
+
+// Regenerate replaces the simple_token used by legacy integrations. The
+// previous token stops working immediately; profiles fetched earlier still
+// hold it and must be refreshed.
+func (r *SimpleTokenService) Regenerate() *SimpleTokenRegenerateCall {
+	c := &SimpleTokenRegenerateCall{s: r.s}
+	return c
+}
+
+func (c *SimpleTokenRegenerateCall) Do() (*SimpleTokenResponse, error) {
+	path := versioned("me/simple-token")
+	ret := &SimpleTokenResponse{}
+	_, err := c.s.post(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type SimpleTokenRevokeCall struct {
+	s *Service
+}
+
+// Revoke disables the simple_token until it is regenerated.
+func (r *SimpleTokenService) Revoke() *SimpleTokenRevokeCall {
+	c := &SimpleTokenRevokeCall{s: r.s}
+	return c
+}
+
+func (c *SimpleTokenRevokeCall) Do() (*SimpleTokenResponse, error) {
+	path := versioned("me/simple-token")
+	ret := &SimpleTokenResponse{}
+	_, err := c.s.delete(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
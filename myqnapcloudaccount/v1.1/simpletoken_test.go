@@ -0,0 +1,78 @@
+package account
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+// fakeSimpleTokenAPI rotates the simple_token reported by /me.
+func fakeSimpleTokenAPI(chk *C) http.HandlerFunc {
+	n := 1
+	token := func() string {
+		if n == 0 {
+			return ""
+		}
+		return fmt.Sprintf("tok-%d", n)
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v1.1/me":
+			fmt.Fprintf(w, `{"code":0,"result":{"user_id":"u1","simple_token":%q}}`, token())
+		case "POST /v1.1/me/simple-token":
+			n++
+			fmt.Fprintf(w, `{"code":0,"result":{"simple_token":%q,"created_at":"2017-02-23T08:00:00Z"}}`, token())
+		case "DELETE /v1.1/me/simple-token":
+			n = 0
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			chk.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_SimpleToken_Regenerate(chk *C) {
+	svc, ts := newFakeService(fakeSimpleTokenAPI(chk))
+	defer ts.Close()
+
+	logs := &bytes.Buffer{}
+	log.SetOutput(logs)
+	defer log.SetOutput(os.Stderr)
+	svc.Debug = true
+
+	me, err := svc.Me.Get().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(me.Result.SimpleToken, Equals, "tok-1")
+
+	res, err := svc.Me.SimpleToken.Regenerate().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(string(res.Result.SimpleToken), Equals, "tok-2")
+	chk.Check(fmt.Sprint(res), Not(Matches), "(?s).*tok-2.*")
+	chk.Check(fmt.Sprintf("%+v %#v", res, res.Result), Not(Matches), "(?s).*tok-2.*")
+	chk.Check(strings.Contains(logs.String(), "tok-2"), Equals, false)
+
+	// The profile fetched before the rotation is stale; a new Get must
+	// reach the server rather than reuse it.
+	chk.Check(me.Result.SimpleToken, Equals, "tok-1")
+	me, err = svc.Me.Get().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(me.Result.SimpleToken, Equals, "tok-2")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_SimpleToken_Revoke(chk *C) {
+	svc, ts := newFakeService(fakeSimpleTokenAPI(chk))
+	defer ts.Close()
+
+	res, err := svc.Me.SimpleToken.Revoke().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(string(res.Result.SimpleToken), Equals, "")
+
+	me, err := svc.Me.Get().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(me.Result.SimpleToken, Equals, "")
+}
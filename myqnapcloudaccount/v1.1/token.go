@@ -0,0 +1,118 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// A TokenInfo describes an access token issued by the account service.
+type TokenInfo struct {
+	// Active is false if the token is expired, revoked, malformed or was
+	// never issued. The other fields are then empty.
+	Active    bool
+	UserID    string
+	Scopes    []string
+	ClientID  string
+	ExpiresAt time.Time
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *TokenInfo) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Active   bool   `json:"active"`
+		UserID   string `json:"user_id"`
+		Scope    string `json:"scope"`
+		ClientID string `json:"client_id"`
+		Exp      int64  `json:"exp"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*t = TokenInfo{
+		Active:   raw.Active,
+		UserID:   raw.UserID,
+		Scopes:   strings.Fields(raw.Scope),
+		ClientID: raw.ClientID,
+	}
+	if raw.Exp != 0 {
+		t.ExpiresAt = time.Unix(raw.Exp, 0)
+	}
+	return nil
+}
+
+type TokenIntrospectResponse struct {
+	Message string    `json:"message"`
+	Code    int       `json:"code"`
+	Result  TokenInfo `json:"result"`
+}
+
+type TokenIntrospectCall struct {
+	s     *Service
+	token string
+}
+
+// Introspect tells whether an access token presented by a user is valid,
+// and for which user and scopes. It does not need the Service to be
+// authenticated as a user. A token that is not valid is not an error: the
+// result has Active unset.
+func (r *TokenService) Introspect(token string) *TokenIntrospectCall {
+	c := &TokenIntrospectCall{s: r.s, token: token}
+	return c
+}
+
+func (c *TokenIntrospectCall) Do() (*TokenIntrospectResponse, error) {
+	if c.token == "" {
+		return nil, errors.New("account: empty token")
+	}
+	path := versioned("oauth/introspect")
+	ret := &TokenIntrospectResponse{}
+	form := url.Values{"token": {c.token}}
+	_, err := c.s.postForm(context.Background(), path, form, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// TokenTypeHint tells Revoke what kind of token it is given, to speed up
+// the lookup. It is only a hint: the token is found either way.
+type TokenTypeHint string
+
+const (
+	HintAccessToken  TokenTypeHint = "access_token"
+	HintRefreshToken TokenTypeHint = "refresh_token"
+)
+
+type TokenRevokeCall struct {
+	s     *Service
+	token string
+	hint  TokenTypeHint
+}
+
+// Revoke revokes an access or refresh token, e.g. on logout. Revoking a
+// refresh token also revokes the access tokens issued from it. Unknown and
+// already revoked tokens are not an error. hint may be empty.
+//
+// The Service must be authenticated as the client the token was issued
+// to; the returned error matches ErrUnauthorized otherwise.
+func (r *TokenService) Revoke(token string, hint TokenTypeHint) *TokenRevokeCall {
+	c := &TokenRevokeCall{s: r.s, token: token, hint: hint}
+	return c
+}
+
+func (c *TokenRevokeCall) Do() error {
+	if c.token == "" {
+		return errors.New("account: empty token")
+	}
+	path := versioned("oauth/revoke")
+	form := url.Values{"token": {c.token}}
+	if c.hint != "" {
+		form.Set("token_type_hint", string(c.hint))
+	}
+	_, err := c.s.postForm(context.Background(), path, form, nil)
+	return err
+}
@@ -0,0 +1,110 @@
+package account
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func fakeIntrospectAPI(chk *C) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/oauth/introspect")
+		chk.Check(r.Header.Get("Content-Type"), Equals, "application/x-www-form-urlencoded")
+		chk.Assert(r.ParseForm(), IsNil)
+		switch r.PostForm.Get("token") {
+		case "at_live_0123456789":
+			fmt.Fprint(w, `{"code":0,"result":{"active":true,"user_id":"u1","scope":"profile:read devices:read","client_id":"nas-app","exp":1487840400}}`)
+		default:
+			// Expired, revoked and malformed tokens alike.
+			fmt.Fprint(w, `{"code":0,"result":{"active":false}}`)
+		}
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Token_Introspect(chk *C) {
+	svc, ts := newFakeService(fakeIntrospectAPI(chk))
+	defer ts.Close()
+
+	logs := &bytes.Buffer{}
+	log.SetOutput(logs)
+	defer log.SetOutput(os.Stderr)
+	svc.Debug = true
+
+	res, err := svc.Token.Introspect("at_live_0123456789").Do()
+	chk.Assert(err, IsNil)
+	info := res.Result
+	chk.Check(info.Active, Equals, true)
+	chk.Check(info.UserID, Equals, "u1")
+	chk.Check(info.Scopes, DeepEquals, []string{"profile:read", "devices:read"})
+	chk.Check(info.ClientID, Equals, "nas-app")
+	chk.Check(info.ExpiresAt.Equal(time.Date(2017, 2, 23, 9, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(strings.Contains(logs.String(), "0123456789"), Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Token_Introspect_Inactive(chk *C) {
+	svc, ts := newFakeService(fakeIntrospectAPI(chk))
+	defer ts.Close()
+
+	for _, token := range []string{"at_expired_42", "not a token"} {
+		res, err := svc.Token.Introspect(token).Do()
+		chk.Assert(err, IsNil)
+		chk.Check(res.Result.Active, Equals, false)
+		chk.Check(res.Result.Scopes, HasLen, 0)
+		chk.Check(res.Result.ExpiresAt.IsZero(), Equals, true)
+	}
+
+	_, err := New(nil).Token.Introspect("").Do()
+	chk.Check(err, ErrorMatches, "account: empty token")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Token_Revoke(chk *C) {
+	var forms []url.Values
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/oauth/revoke")
+		chk.Check(r.Header.Get("Content-Type"), Equals, "application/x-www-form-urlencoded")
+		chk.Assert(r.ParseForm(), IsNil)
+		forms = append(forms, r.PostForm)
+		// The token is unknown or revoked: success all the same.
+		w.WriteHeader(http.StatusOK)
+	})
+	defer ts.Close()
+
+	logs := &bytes.Buffer{}
+	log.SetOutput(logs)
+	defer log.SetOutput(os.Stderr)
+	svc.Debug = true
+
+	chk.Assert(svc.Token.Revoke("at_live_0123456789", HintAccessToken).Do(), IsNil)
+	chk.Assert(svc.Token.Revoke("rt_live_9876543210", HintRefreshToken).Do(), IsNil)
+	chk.Assert(svc.Token.Revoke("rt_live_9876543210", "").Do(), IsNil)
+	chk.Check(forms, DeepEquals, []url.Values{
+		{"token": {"at_live_0123456789"}, "token_type_hint": {"access_token"}},
+		{"token": {"rt_live_9876543210"}, "token_type_hint": {"refresh_token"}},
+		{"token": {"rt_live_9876543210"}},
+	})
+	chk.Check(strings.Contains(logs.String(), "0123456789"), Equals, false)
+	chk.Check(strings.Contains(logs.String(), "9876543210"), Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_Token_Revoke_Unauthorized(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error":"invalid_client"}`)
+	})
+	defer ts.Close()
+
+	err := svc.Token.Revoke("rt_live_9876543210", HintRefreshToken).Do()
+	chk.Check(errors.Is(err, ErrUnauthorized), Equals, true)
+	chk.Check(errors.Is(err, ErrForbidden), Equals, false)
+}
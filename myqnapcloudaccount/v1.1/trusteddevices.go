@@ -0,0 +1,125 @@
+package account
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// A TrustedDevice is a device allowed to sign in without the second
+// authentication factor.
+type TrustedDevice struct {
+	ID         string    `json:"device_id"`
+	Name       string    `json:"name"`
+	Platform   string    `json:"platform"`
+	AddedAt    time.Time `json:"added_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
+
+type TrustedDeviceListResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Devices       []*TrustedDevice `json:"devices"`
+		NextPageToken string           `json:"next_page_token"`
+	} `json:"result"`
+}
+
+type TrustedDevicesService struct {
+	s *Service
+}
+
+func NewTrustedDevicesService(s *Service) *TrustedDevicesService {
+	rs := &TrustedDevicesService{s: s}
+	return rs
+}
+
+type TrustedDeviceListCall struct {
+	s      *Service
+	params url.Values
+}
+
+// List lists the trusted devices of the account.
+func (r *TrustedDevicesService) List() *TrustedDeviceListCall {
+	c := &TrustedDeviceListCall{s: r.s, params: url.Values{}}
+	return c
+}
+
+// PageSize sets the maximum number of devices returned per page.
+func (c *TrustedDeviceListCall) PageSize(n int) *TrustedDeviceListCall {
+	c.params.Set("page_size", strconv.Itoa(n))
+	return c
+}
+
+// PageToken sets the page to retrieve, as returned in NextPageToken
+// by a previous call.
+func (c *TrustedDeviceListCall) PageToken(token string) *TrustedDeviceListCall {
+	c.params.Set("page_token", token)
+	return c
+}
+
+func (c *TrustedDeviceListCall) Do() (*TrustedDeviceListResponse, error) {
+	path := versioned("me/trusted-devices")
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &TrustedDeviceListResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type TrustedDeviceRemoveResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Removed int `json:"removed"`
+
+		// CurrentDevice is set when a removed device is the one the
+		// current session was opened from.
+		CurrentDevice bool `json:"current_device"`
+	} `json:"result"`
+}
+
+type TrustedDeviceRemoveCall struct {
+	s  *Service
+	id string
+}
+
+// Remove stops trusting a device.
+func (r *TrustedDevicesService) Remove(deviceID string) *TrustedDeviceRemoveCall {
+	c := &TrustedDeviceRemoveCall{s: r.s, id: deviceID}
+	return c
+}
+
+func (c *TrustedDeviceRemoveCall) Do() (*TrustedDeviceRemoveResponse, error) {
+	path := versioned("me/trusted-devices/" + url.PathEscape(c.id))
+	ret := &TrustedDeviceRemoveResponse{}
+	_, err := c.s.delete(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type TrustedDeviceRemoveAllCall struct {
+	s *Service
+}
+
+// RemoveAll stops trusting every device of the account.
+func (r *TrustedDevicesService) RemoveAll() *TrustedDeviceRemoveAllCall {
+	c := &TrustedDeviceRemoveAllCall{s: r.s}
+	return c
+}
+
+func (c *TrustedDeviceRemoveAllCall) Do() (*TrustedDeviceRemoveResponse, error) {
+	path := versioned("me/trusted-devices")
+	ret := &TrustedDeviceRemoveResponse{}
+	_, err := c.s.delete(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,73 @@
+package account
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_TrustedDevices_List(chk *C) {
+	var query string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/trusted-devices")
+		query = r.URL.RawQuery
+		fmt.Fprint(w, `{"code":0,"result":{"devices":[
+			{"device_id":"d1","name":"Alice's iPhone","platform":"ios",
+			 "added_at":"2016-12-01T00:00:00Z","last_used_at":"2017-02-22T09:00:00Z"},
+			{"device_id":"d2","name":"Office PC","platform":"windows","added_at":"2017-01-10T00:00:00Z"}
+		],"next_page_token":"t2"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.TrustedDevices.List().PageSize(2).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(query, Equals, "page_size=2")
+	chk.Check(res.Result.NextPageToken, Equals, "t2")
+	d := res.Result.Devices
+	chk.Assert(d, HasLen, 2)
+	chk.Check(d[0].ID, Equals, "d1")
+	chk.Check(d[0].Platform, Equals, "ios")
+	chk.Check(d[0].LastUsedAt.Equal(time.Date(2017, 2, 22, 9, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(d[1].LastUsedAt.IsZero(), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_TrustedDevices_Remove(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "DELETE")
+		switch r.URL.EscapedPath() {
+		case "/v1.1/me/trusted-devices/d2":
+			fmt.Fprint(w, `{"code":0,"result":{"removed":1,"current_device":false}}`)
+		case "/v1.1/me/trusted-devices/d%2F1":
+			fmt.Fprint(w, `{"code":0,"result":{"removed":1,"current_device":true}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.TrustedDevices.Remove("d2").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Removed, Equals, 1)
+	chk.Check(res.Result.CurrentDevice, Equals, false)
+
+	res, err = svc.Me.TrustedDevices.Remove("d/1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.CurrentDevice, Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_TrustedDevices_RemoveAll(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "DELETE")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/trusted-devices")
+		fmt.Fprint(w, `{"code":0,"result":{"removed":3,"current_device":true}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.TrustedDevices.RemoveAll().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Removed, Equals, 3)
+	chk.Check(res.Result.CurrentDevice, Equals, true)
+}
@@ -0,0 +1,236 @@
+package account
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// TwoFactorStatus describes the two-factor authentication setup of the
+// account.
+type TwoFactorStatus struct {
+	Enabled bool `json:"enabled"`
+
+	// Method is the second factor in use, e.g. "totp". Empty when disabled.
+	Method     string    `json:"method"`
+	EnrolledAt time.Time `json:"enrolled_at"`
+}
+
+type TwoFactorStatusResponse struct {
+	Message string          `json:"message"`
+	Code    int             `json:"code"`
+	Result  TwoFactorStatus `json:"result"`
+}
+
+type TwoFactorService struct {
+	s *Service
+}
+
+func NewTwoFactorService(s *Service) *TwoFactorService {
+	rs := &TwoFactorService{s: s}
+	return rs
+}
+
+type twoFactorCode struct {
+	Code string `json:"code"`
+}
+
+type TwoFactorStatusCall struct {
+	s *Service
+}
+
+// Status retrieves the two-factor authentication setup of the account.
+func (r *TwoFactorService) Status() *TwoFactorStatusCall {
+	c := &TwoFactorStatusCall{s: r.s}
+	return c
+}
+
+func (c *TwoFactorStatusCall) Do() (*TwoFactorStatusResponse, error) {
+	path := versioned("me/2fa")
+	ret := &TwoFactorStatusResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type TwoFactorEnableResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Secret is the base32 TOTP key to load into an authenticator.
+		Secret Secret `json:"secret"`
+
+		// URI is the otpauth:// provisioning URI embedding Secret,
+		// usually rendered as a QR code.
+		URI Secret `json:"otpauth_uri"`
+	} `json:"result"`
+}
+
+type TwoFactorEnableTOTPCall struct {
+	s *Service
+}
+
+// EnableTOTP starts enrolling an authenticator app. Enrollment completes
+// once a code generated from the returned secret is passed to
+// ConfirmEnable.
+func (r *TwoFactorService) EnableTOTP() *TwoFactorEnableTOTPCall {
+	c := &TwoFactorEnableTOTPCall{s: r.s}
+	return c
+}
+
+func (c *TwoFactorEnableTOTPCall) Do() (*TwoFactorEnableResponse, error) {
+	path := versioned("me/2fa/totp")
+	ret := &TwoFactorEnableResponse{}
+	_, err := c.s.post(path, nil, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type TwoFactorConfirmEnableCall struct {
+	s    *Service
+	code string
+}
+
+// ConfirmEnable completes the enrollment started by EnableTOTP. A wrong
+// code yields an error matching ErrWrongCode.
+func (r *TwoFactorService) ConfirmEnable(code string) *TwoFactorConfirmEnableCall {
+	c := &TwoFactorConfirmEnableCall{s: r.s, code: code}
+	return c
+}
+
+func (c *TwoFactorConfirmEnableCall) Do() (*TwoFactorStatusResponse, error) {
+	path := versioned("me/2fa/totp/confirm")
+	ret := &TwoFactorStatusResponse{}
+	_, err := c.s.post(path, twoFactorCode{c.code}, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type TwoFactorDisableCall struct {
+	s    *Service
+	code string
+}
+
+// Disable turns two-factor authentication off. A wrong code yields an
+// error matching ErrWrongCode.
+func (r *TwoFactorService) Disable(code string) *TwoFactorDisableCall {
+	c := &TwoFactorDisableCall{s: r.s, code: code}
+	return c
+}
+
+func (c *TwoFactorDisableCall) Do() (*TwoFactorStatusResponse, error) {
+	path := versioned("me/2fa")
+	ret := &TwoFactorStatusResponse{}
+	_, err := c.s.delete(path, twoFactorCode{c.code}, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ErrReauthRequired is matched by errors.Is when a sensitive call needs a
+// fresh proof of identity, such as a current TOTP code.
+var ErrReauthRequired = errors.New("account: re-authentication required")
+
+const codeReauthRequired = 40101
+
+// BackupCodes are single-use two-factor recovery codes. They print
+// masked; index or range over them to use the codes.
+type BackupCodes []string
+
+// Redacted returns the codes with every letter and digit masked.
+func (c BackupCodes) Redacted() string {
+	masked := make([]string, len(c))
+	for i, code := range c {
+		masked[i] = strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return '*'
+			}
+			return r
+		}, code)
+	}
+	return "[" + strings.Join(masked, " ") + "]"
+}
+
+// String implements fmt.Stringer, returning the redacted form.
+func (c BackupCodes) String() string {
+	return c.Redacted()
+}
+
+// GoString implements fmt.GoStringer, returning the redacted form.
+func (c BackupCodes) GoString() string {
+	return c.Redacted()
+}
+
+type TwoFactorBackupCodesResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Remaining is the number of unused codes. The API never returns
+		// existing codes.
+		Remaining int `json:"remaining"`
+	} `json:"result"`
+}
+
+type TwoFactorBackupCodesCall struct {
+	s *Service
+}
+
+// BackupCodes retrieves the number of unused backup codes.
+func (r *TwoFactorService) BackupCodes() *TwoFactorBackupCodesCall {
+	c := &TwoFactorBackupCodesCall{s: r.s}
+	return c
+}
+
+func (c *TwoFactorBackupCodesCall) Do() (*TwoFactorBackupCodesResponse, error) {
+	path := versioned("me/2fa/backup-codes")
+	ret := &TwoFactorBackupCodesResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type TwoFactorRegenerateBackupCodesResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Codes is the new set, replacing all previous codes. It is only
+		// ever returned here.
+		Codes BackupCodes `json:"codes"`
+	} `json:"result"`
+}
+
+type TwoFactorRegenerateBackupCodesCall struct {
+	s    *Service
+	code string
+}
+
+// RegenerateBackupCodes invalidates the backup codes and issues a new set.
+// code is a current TOTP code; a missing or wrong one yields an error
+// matching ErrReauthRequired.
+func (r *TwoFactorService) RegenerateBackupCodes(code string) *TwoFactorRegenerateBackupCodesCall {
+	c := &TwoFactorRegenerateBackupCodesCall{s: r.s, code: code}
+	return c
+}
+
+func (c *TwoFactorRegenerateBackupCodesCall) Do() (*TwoFactorRegenerateBackupCodesResponse, error) {
+	if c.code == "" {
+		return nil, ErrReauthRequired
+	}
+	path := versioned("me/2fa/backup-codes")
+	ret := &TwoFactorRegenerateBackupCodesResponse{}
+	_, err := c.s.post(path, twoFactorCode{c.code}, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
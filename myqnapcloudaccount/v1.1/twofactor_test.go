@@ -0,0 +1,139 @@
+package account
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+const totpSecret = "JBSWY3DPEHPK3PXP"
+
+// fakeTwoFactorAPI enrolls TOTP accepting only the code "123456".
+func fakeTwoFactorAPI(chk *C) http.HandlerFunc {
+	enabled := false
+	status := func(w http.ResponseWriter) {
+		if enabled {
+			fmt.Fprint(w, `{"code":0,"result":{"enabled":true,"method":"totp","enrolled_at":"2017-02-23T08:00:00Z"}}`)
+		} else {
+			fmt.Fprint(w, `{"code":0,"result":{"enabled":false}}`)
+		}
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v1.1/me/2fa":
+			status(w)
+		case "POST /v1.1/me/2fa/totp":
+			fmt.Fprintf(w, `{"code":0,"result":{"secret":%q,"otpauth_uri":"otpauth://totp/myQNAPcloud:me?secret=%s"}}`,
+				totpSecret, totpSecret)
+		case "POST /v1.1/me/2fa/totp/confirm", "DELETE /v1.1/me/2fa":
+			if body["code"] != "123456" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"code":40002,"message":"wrong code"}`)
+				return
+			}
+			enabled = r.Method == "POST"
+			status(w)
+		default:
+			chk.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_TwoFactor_Enroll(chk *C) {
+	svc, ts := newFakeService(fakeTwoFactorAPI(chk))
+	defer ts.Close()
+
+	logs := &bytes.Buffer{}
+	log.SetOutput(logs)
+	defer log.SetOutput(os.Stderr)
+	svc.Debug = true
+
+	st, err := svc.Me.TwoFactor.Status().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(st.Result.Enabled, Equals, false)
+	chk.Check(st.Result.EnrolledAt.IsZero(), Equals, true)
+
+	en, err := svc.Me.TwoFactor.EnableTOTP().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(string(en.Result.Secret), Equals, totpSecret)
+	chk.Check(strings.HasPrefix(string(en.Result.URI), "otpauth://totp/"), Equals, true)
+	chk.Check(fmt.Sprintf("%+v", en), Not(Matches), "(?s).*"+totpSecret+".*")
+
+	st, err = svc.Me.TwoFactor.ConfirmEnable("123456").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(st.Result.Enabled, Equals, true)
+	chk.Check(st.Result.Method, Equals, "totp")
+	chk.Check(st.Result.EnrolledAt.Equal(time.Date(2017, 2, 23, 8, 0, 0, 0, time.UTC)), Equals, true)
+
+	st, err = svc.Me.TwoFactor.Disable("123456").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(st.Result.Enabled, Equals, false)
+
+	chk.Check(strings.Contains(logs.String(), totpSecret), Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_TwoFactor_WrongCode(chk *C) {
+	svc, ts := newFakeService(fakeTwoFactorAPI(chk))
+	defer ts.Close()
+
+	_, err := svc.Me.TwoFactor.EnableTOTP().Do()
+	chk.Assert(err, IsNil)
+	_, err = svc.Me.TwoFactor.ConfirmEnable("000000").Do()
+	chk.Check(errors.Is(err, ErrWrongCode), Equals, true)
+
+	st, err := svc.Me.TwoFactor.Status().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(st.Result.Enabled, Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_TwoFactor_BackupCodes(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/2fa/backup-codes")
+		fmt.Fprint(w, `{"code":0,"result":{"remaining":7}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.TwoFactor.BackupCodes().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Remaining, Equals, 7)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_TwoFactor_RegenerateBackupCodes(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/me/2fa/backup-codes")
+		var body map[string]string
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		if body["code"] != "123456" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"code":40101,"message":"re-authentication required"}`)
+			return
+		}
+		fmt.Fprint(w, `{"code":0,"result":{"codes":["a1b2-c3d4","e5f6-g7h8"]}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.Me.TwoFactor.RegenerateBackupCodes("123456").Do()
+	chk.Assert(err, IsNil)
+	codes := res.Result.Codes
+	chk.Check([]string(codes), DeepEquals, []string{"a1b2-c3d4", "e5f6-g7h8"})
+	chk.Check(codes.Redacted(), Equals, "[****-**** ****-****]")
+	chk.Check(fmt.Sprintf("%v %+v %#v", codes, res, res), Not(Matches), "(?s).*(a1b2|e5f6).*")
+
+	_, err = svc.Me.TwoFactor.RegenerateBackupCodes("000000").Do()
+	chk.Check(errors.Is(err, ErrReauthRequired), Equals, true)
+
+	_, err = svc.Me.TwoFactor.RegenerateBackupCodes("").Do()
+	chk.Check(err, Equals, ErrReauthRequired)
+}
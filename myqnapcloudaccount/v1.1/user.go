@@ -0,0 +1,87 @@
+package account
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	minDisplayName = 2
+	maxDisplayName = 32
+)
+
+// validateDisplayName applies the display name rules of the API: 2 to 32
+// characters among letters, digits, spaces, '.', '_' and '-', not starting
+// or ending with a space.
+func validateDisplayName(name string) error {
+	if n := utf8.RuneCountInString(name); n < minDisplayName || n > maxDisplayName {
+		return fmt.Errorf("account: display name must be %d to %d characters long", minDisplayName, maxDisplayName)
+	}
+	if strings.TrimSpace(name) != name {
+		return fmt.Errorf("account: display name %q starts or ends with a space", name)
+	}
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune(" ._-", r) {
+			return fmt.Errorf("account: display name %q contains invalid character %q", name, r)
+		}
+	}
+	return nil
+}
+
+type UserService struct {
+	s *Service
+
+	// NameCacheSize is the maximum number of display names ResolveNames
+	// keeps for NamesTTL. Zero, the default, disables the cache.
+	NameCacheSize int
+
+	languages languageCache
+	names     nameCache
+}
+
+func NewUserService(s *Service) *UserService {
+	rs := &UserService{s: s}
+	return rs
+}
+
+type DisplayNameAvailabilityResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		Available bool `json:"available"`
+
+		// Suggestions are available alternatives to a taken name, if the
+		// API offered any.
+		Suggestions []string `json:"suggestions"`
+	} `json:"result"`
+}
+
+type UserCheckDisplayNameCall struct {
+	s    *Service
+	name string
+}
+
+// CheckDisplayName tells whether a display name is still available. Names
+// breaking the display name rules are rejected without a request.
+func (r *UserService) CheckDisplayName(name string) *UserCheckDisplayNameCall {
+	c := &UserCheckDisplayNameCall{s: r.s, name: name}
+	return c
+}
+
+func (c *UserCheckDisplayNameCall) Do() (*DisplayNameAvailabilityResponse, error) {
+	if err := validateDisplayName(c.name); err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Set("name", c.name)
+	path := versioned("users/display-names/availability") + "?" + params.Encode()
+	ret := &DisplayNameAvailabilityResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,50 @@
+package account
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_CheckDisplayName(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.URL.Path, Equals, "/v1.1/users/display-names/availability")
+		switch r.URL.Query().Get("name") {
+		case "Alice Chen":
+			fmt.Fprint(w, `{"code":0,"result":{"available":true}}`)
+		case "alice":
+			fmt.Fprint(w, `{"code":0,"result":{"available":false,"suggestions":["alice_1","alice.tw"]}}`)
+		default:
+			chk.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.User.CheckDisplayName("Alice Chen").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Available, Equals, true)
+	chk.Check(res.Result.Suggestions, HasLen, 0)
+
+	res, err = svc.User.CheckDisplayName("alice").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Available, Equals, false)
+	chk.Check(res.Result.Suggestions, DeepEquals, []string{"alice_1", "alice.tw"})
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_CheckDisplayName_Invalid(chk *C) {
+	called := false
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+	defer ts.Close()
+
+	for _, name := range []string{"a", strings.Repeat("x", 33), " alice", "alice!", "<b>"} {
+		_, err := svc.User.CheckDisplayName(name).Do()
+		chk.Check(err, ErrorMatches, "account: display name .*", Commentf("%q", name))
+	}
+	_, err := svc.Me.Update().DisplayName("alice!").Do()
+	chk.Check(err, NotNil)
+	chk.Check(called, Equals, false)
+}
@@ -0,0 +1,143 @@
+package account
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// NamesTTL is how long display names cached by User.ResolveNames are
+// reused before being resolved again.
+var NamesTTL = time.Hour
+
+// MaxResolveNames is the maximum number of users the API accepts per name
+// resolution request. ResolveNames splits larger batches.
+const MaxResolveNames = 200
+
+type cachedName struct {
+	name       string
+	resolvedAt time.Time
+}
+
+// nameCache holds the display names resolved so far, by user ID.
+type nameCache struct {
+	mu    sync.Mutex
+	names map[string]cachedName
+}
+
+// get returns the names of ids younger than NamesTTL, and the ids left to
+// resolve.
+func (nc *nameCache) get(ids []string) (map[string]string, []string) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+	found := make(map[string]string, len(ids))
+	var missing []string
+	for _, id := range ids {
+		if n, ok := nc.names[id]; ok && now().Sub(n.resolvedAt) < NamesTTL {
+			found[id] = n.name
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	return found, missing
+}
+
+// set adds names to the cache, then evicts the expired names and, past
+// size names, the oldest ones.
+func (nc *nameCache) set(names map[string]string, size int) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+	if size <= 0 {
+		nc.names = nil
+		return
+	}
+	if nc.names == nil {
+		nc.names = make(map[string]cachedName, len(names))
+	}
+	t := now()
+	for id, name := range names {
+		nc.names[id] = cachedName{name, t}
+	}
+	for id, n := range nc.names {
+		if t.Sub(n.resolvedAt) >= NamesTTL {
+			delete(nc.names, id)
+		}
+	}
+	if len(nc.names) <= size {
+		return
+	}
+	ids := make([]string, 0, len(nc.names))
+	for id := range nc.names {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return nc.names[ids[i]].resolvedAt.Before(nc.names[ids[j]].resolvedAt)
+	})
+	for _, id := range ids[:len(ids)-size] {
+		delete(nc.names, id)
+	}
+}
+
+type UserResolveNamesCall struct {
+	r       *UserService
+	ids     []string
+	refresh bool
+}
+
+// ResolveNames retrieves the display names of users, by user ID. IDs
+// matching no user are absent from the result. If the NameCacheSize of
+// the service is set, names are cached for NamesTTL.
+func (r *UserService) ResolveNames(userIDs []string) *UserResolveNamesCall {
+	c := &UserResolveNamesCall{r: r, ids: userIDs}
+	return c
+}
+
+// Refresh resolves all the names, even those cached.
+func (c *UserResolveNamesCall) Refresh() *UserResolveNamesCall {
+	c.refresh = true
+	return c
+}
+
+func (c *UserResolveNamesCall) Do() (map[string]string, error) {
+	var ids []string
+	seen := make(map[string]bool, len(c.ids))
+	for _, id := range c.ids {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	names := map[string]string{}
+	if !c.refresh && c.r.NameCacheSize > 0 {
+		names, ids = c.r.names.get(ids)
+	}
+	path := versioned("users/names")
+	for len(ids) > 0 {
+		chunk := ids
+		if len(chunk) > MaxResolveNames {
+			chunk = chunk[:MaxResolveNames]
+		}
+		ids = ids[len(chunk):]
+
+		payload := struct {
+			UserIDs []string `json:"user_ids"`
+		}{chunk}
+		res := &struct {
+			Message string `json:"message"`
+			Code    int    `json:"code"`
+			Result  struct {
+				Names map[string]string `json:"names"`
+			} `json:"result"`
+		}{}
+		_, err := c.r.s.post(path, payload, res)
+		if err != nil {
+			return nil, err
+		}
+		c.r.names.set(res.Result.Names, c.r.NameCacheSize)
+		for id, name := range res.Result.Names {
+			names[id] = name
+		}
+	}
+	return names, nil
+}
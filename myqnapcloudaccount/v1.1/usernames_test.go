@@ -0,0 +1,121 @@
+package account
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func fakeNamesAPI(chk *C, requested *[][]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/users/names")
+		var body struct {
+			UserIDs []string `json:"user_ids"`
+		}
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		*requested = append(*requested, body.UserIDs)
+		names := map[string]string{}
+		for _, id := range body.UserIDs {
+			if id != "gone" {
+				names[id] = "Name of " + id
+			}
+		}
+		res := map[string]interface{}{"code": 0, "result": map[string]interface{}{"names": names}}
+		json.NewEncoder(w).Encode(res)
+	}
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_ResolveNames(chk *C) {
+	defer func(f func() time.Time) { now = f }(now)
+	t := time.Date(2017, 2, 23, 8, 0, 0, 0, time.UTC)
+	now = func() time.Time { return t }
+
+	var requested [][]string
+	svc, ts := newFakeService(fakeNamesAPI(chk, &requested))
+	defer ts.Close()
+	svc.User.NameCacheSize = 1000
+
+	var ids []string
+	for i := 0; i < MaxResolveNames+1; i++ {
+		ids = append(ids, fmt.Sprintf("u%d", i))
+	}
+	ids = append(ids, "gone", "u0")
+
+	names, err := svc.User.ResolveNames(ids).Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(requested, HasLen, 2)
+	chk.Check(requested[0], HasLen, MaxResolveNames)
+	chk.Check(requested[1], DeepEquals, []string{fmt.Sprintf("u%d", MaxResolveNames), "gone"})
+	chk.Check(names, HasLen, MaxResolveNames+1)
+	chk.Check(names["u7"], Equals, "Name of u7")
+	_, ok := names["gone"]
+	chk.Check(ok, Equals, false)
+
+	// Cached names are not requested again; missing ones are.
+	requested = nil
+	names, err = svc.User.ResolveNames([]string{"u1", "u2", "gone"}).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(requested, DeepEquals, [][]string{{"gone"}})
+	chk.Check(names, DeepEquals, map[string]string{"u1": "Name of u1", "u2": "Name of u2"})
+
+	requested = nil
+	t = t.Add(NamesTTL / 2)
+	_, err = svc.User.ResolveNames([]string{"u1"}).Refresh().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(requested, DeepEquals, [][]string{{"u1"}})
+
+	// Only u2 outlived NamesTTL; u1 was refreshed.
+	requested = nil
+	t = t.Add(NamesTTL / 2)
+	_, err = svc.User.ResolveNames([]string{"u1", "u2"}).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(requested, DeepEquals, [][]string{{"u2"}})
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_ResolveNames_NoCache(chk *C) {
+	var requested [][]string
+	svc, ts := newFakeService(fakeNamesAPI(chk, &requested))
+	defer ts.Close()
+
+	for i := 0; i < 2; i++ {
+		names, err := svc.User.ResolveNames([]string{"u1"}).Do()
+		chk.Assert(err, IsNil)
+		chk.Check(names, DeepEquals, map[string]string{"u1": "Name of u1"})
+	}
+	chk.Check(requested, DeepEquals, [][]string{{"u1"}, {"u1"}})
+	chk.Check(svc.User.names.names, HasLen, 0)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_ResolveNames_CacheEviction(chk *C) {
+	defer func(f func() time.Time) { now = f }(now)
+	t := time.Date(2017, 2, 23, 8, 0, 0, 0, time.UTC)
+	now = func() time.Time { return t }
+
+	var requested [][]string
+	svc, ts := newFakeService(fakeNamesAPI(chk, &requested))
+	defer ts.Close()
+	svc.User.NameCacheSize = 2
+
+	_, err := svc.User.ResolveNames([]string{"u1"}).Do()
+	chk.Assert(err, IsNil)
+	t = t.Add(time.Minute)
+	_, err = svc.User.ResolveNames([]string{"u2", "u3"}).Do()
+	chk.Assert(err, IsNil)
+
+	// The oldest name made room for the newer ones.
+	chk.Check(svc.User.names.names, HasLen, 2)
+	requested = nil
+	_, err = svc.User.ResolveNames([]string{"u1", "u2", "u3"}).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(requested, DeepEquals, [][]string{{"u1"}})
+
+	// Expired names are dropped when names are added.
+	t = t.Add(NamesTTL)
+	_, err = svc.User.ResolveNames([]string{"u4"}).Do()
+	chk.Assert(err, IsNil)
+	chk.Check(svc.User.names.names, HasLen, 1)
+}
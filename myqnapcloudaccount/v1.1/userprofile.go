@@ -0,0 +1,240 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+// A PublicProfile is the profile of a user as seen by other users.
+type PublicProfile struct {
+	UserID      string    `json:"user_id"`
+	DisplayName string    `json:"display_name"`
+	AvatarURL   string    `json:"avatar_url"`
+	Country     string    `json:"country"` // ISO 3166-1 alpha-2
+	MemberSince time.Time `json:"member_since"`
+
+	// Restricted is set when the privacy settings of the user hide their
+	// profile; only UserID is set then.
+	Restricted bool `json:"restricted"`
+
+	// Relationship is the relationship of the account with the user. It
+	// is only returned when requested with UserGetCall.IncludeRelationship
+	// and allowed by the privacy settings of the user, and nil otherwise.
+	Relationship *UserRelationship `json:"relationship"`
+}
+
+// Relationship is the relationship of the account with another user.
+type Relationship string
+
+const (
+	RelationshipNone            Relationship = "none"
+	RelationshipFriend          Relationship = "friend"
+	RelationshipPendingSent     Relationship = "pending_sent"
+	RelationshipPendingReceived Relationship = "pending_received"
+	RelationshipBlocked         Relationship = "blocked"
+)
+
+// A UserRelationship describes the relationship of the account with
+// another user.
+type UserRelationship struct {
+	Status Relationship `json:"status"`
+
+	// InvitationID is the pending invitation, for RelationshipPendingSent
+	// and RelationshipPendingReceived.
+	InvitationID string `json:"invitation_id"`
+}
+
+type UserGetResponse struct {
+	Message string        `json:"message"`
+	Code    int           `json:"code"`
+	Result  PublicProfile `json:"result"`
+}
+
+type UserGetCall struct {
+	s      *Service
+	id     string
+	params url.Values
+}
+
+// Get retrieves the public profile of a user. Unknown IDs yield an error
+// matching ErrNotFound.
+func (r *UserService) Get(userID string) *UserGetCall {
+	c := &UserGetCall{s: r.s, id: userID, params: url.Values{}}
+	return c
+}
+
+// IncludeRelationship asks for the relationship of the account with the
+// user.
+func (c *UserGetCall) IncludeRelationship() *UserGetCall {
+	c.params.Set("include", "relationship")
+	return c
+}
+
+func (c *UserGetCall) Do() (*UserGetResponse, error) {
+	path := versioned("users/" + url.PathEscape(c.id))
+	if len(c.params) > 0 {
+		path += "?" + c.params.Encode()
+	}
+	ret := &UserGetResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type UserLookupByEmailCall struct {
+	s     *Service
+	email string
+}
+
+// LookupByEmail retrieves the public profile of the user registered with
+// an email address. Users who made their account unsearchable by email
+// (see PrivacyUpdateCall.SearchableByEmail) cannot be told apart from
+// unregistered addresses: both yield an error matching ErrNotFound.
+func (r *UserService) LookupByEmail(email string) *UserLookupByEmailCall {
+	c := &UserLookupByEmailCall{s: r.s, email: email}
+	return c
+}
+
+func (c *UserLookupByEmailCall) Do() (*UserGetResponse, error) {
+	if err := validateEmail(c.email); err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Set("email", c.email)
+	path := versioned("users/lookup") + "?" + params.Encode()
+	ret := &UserGetResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// MaxBatchGet is the maximum number of users the API accepts per batch
+// profile request. BatchGet splits larger batches.
+const MaxBatchGet = 100
+
+type UserBatchGetResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// Profiles holds the profiles found, by user ID.
+		Profiles map[string]*PublicProfile `json:"-"`
+
+		// NotFound lists the requested IDs matching no user.
+		NotFound []string `json:"not_found"`
+	} `json:"result"`
+}
+
+type UserBatchGetCall struct {
+	s   *Service
+	ids []string
+}
+
+// BatchGet retrieves the public profiles of several users at once.
+// Duplicate IDs are requested once.
+func (r *UserService) BatchGet(userIDs []string) *UserBatchGetCall {
+	c := &UserBatchGetCall{s: r.s, ids: userIDs}
+	return c
+}
+
+func (c *UserBatchGetCall) Do() (*UserBatchGetResponse, error) {
+	var ids []string
+	seen := make(map[string]bool, len(c.ids))
+	for _, id := range c.ids {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	path := versioned("users/batch")
+	ret := &UserBatchGetResponse{}
+	ret.Result.Profiles = make(map[string]*PublicProfile, len(ids))
+	for len(ids) > 0 {
+		chunk := ids
+		if len(chunk) > MaxBatchGet {
+			chunk = chunk[:MaxBatchGet]
+		}
+		ids = ids[len(chunk):]
+
+		payload := struct {
+			UserIDs []string `json:"user_ids"`
+		}{chunk}
+		res := &struct {
+			Message string `json:"message"`
+			Code    int    `json:"code"`
+			Result  struct {
+				Profiles []*PublicProfile `json:"profiles"`
+				NotFound []string         `json:"not_found"`
+			} `json:"result"`
+		}{}
+		_, err := c.s.post(path, payload, res)
+		if err != nil {
+			return nil, err
+		}
+		ret.Message, ret.Code = res.Message, res.Code
+		for _, p := range res.Result.Profiles {
+			ret.Result.Profiles[p.UserID] = p
+		}
+		ret.Result.NotFound = append(ret.Result.NotFound, res.Result.NotFound...)
+	}
+	return ret, nil
+}
+
+type UserExistsCall struct {
+	s  *Service
+	id string
+}
+
+// Exists tells whether a user exists, without retrieving their profile.
+func (r *UserService) Exists(userID string) *UserExistsCall {
+	c := &UserExistsCall{s: r.s, id: userID}
+	return c
+}
+
+func (c *UserExistsCall) Do() (bool, error) {
+	path := versioned("users/" + url.PathEscape(c.id))
+	_, err := c.s.head(path)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// qidPattern is the format of a QID: "Q" followed by 6 to 12 digits.
+var qidPattern = regexp.MustCompile(`^Q[0-9]{6,12}$`)
+
+type UserGetByQIDCall struct {
+	s   *Service
+	qid string
+}
+
+// GetByQID retrieves the public profile of the user with a QID, such as
+// "Q1234567". Malformed QIDs are rejected without a request; well-formed
+// but unknown ones yield an error matching ErrNotFound.
+func (r *UserService) GetByQID(qid string) *UserGetByQIDCall {
+	c := &UserGetByQIDCall{s: r.s, qid: qid}
+	return c
+}
+
+func (c *UserGetByQIDCall) Do() (*UserGetResponse, error) {
+	if !qidPattern.MatchString(c.qid) {
+		return nil, fmt.Errorf("account: malformed QID %q", c.qid)
+	}
+	path := versioned("users/qid/" + c.qid)
+	ret := &UserGetResponse{}
+	_, err := c.s.get(path, ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,208 @@
+package account
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_Get(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		switch r.URL.EscapedPath() {
+		case "/v1.1/users/u1":
+			fmt.Fprint(w, `{"code":0,"result":{"user_id":"u1","display_name":"Alice","avatar_url":"https://cdn.example.com/a.png",
+				"country":"TW","member_since":"2014-03-01T00:00:00Z"}}`)
+		case "/v1.1/users/u%2F2":
+			fmt.Fprint(w, `{"code":0,"result":{"user_id":"u/2","restricted":true}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":40400,"message":"user not found"}`)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.User.Get("u1").Do()
+	chk.Assert(err, IsNil)
+	p := res.Result
+	chk.Check(p.DisplayName, Equals, "Alice")
+	chk.Check(p.Country, Equals, "TW")
+	chk.Check(p.MemberSince.Equal(time.Date(2014, 3, 1, 0, 0, 0, 0, time.UTC)), Equals, true)
+	chk.Check(p.Restricted, Equals, false)
+
+	res, err = svc.User.Get("u/2").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Restricted, Equals, true)
+	chk.Check(res.Result.DisplayName, Equals, "")
+	chk.Check(res.Result.Relationship, IsNil)
+
+	_, err = svc.User.Get("u3").Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_LookupByEmail(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		chk.Check(r.URL.Path, Equals, "/v1.1/users/lookup")
+		switch r.URL.RawQuery {
+		case "email=alice%2Bnas%40example.com":
+			fmt.Fprint(w, `{"code":0,"result":{"user_id":"u1","display_name":"Alice"}}`)
+		case "email=hidden%40example.com", "email=nobody%40example.com":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":40400,"message":"user not found"}`)
+		default:
+			chk.Errorf("unexpected query %q", r.URL.RawQuery)
+		}
+	})
+	defer ts.Close()
+
+	res, err := svc.User.LookupByEmail("alice+nas@example.com").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.UserID, Equals, "u1")
+
+	_, err = svc.User.LookupByEmail("nobody@example.com").Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+	_, err = svc.User.LookupByEmail("hidden@example.com").Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+
+	_, err = svc.User.LookupByEmail("alice").Do()
+	chk.Check(err, ErrorMatches, "account: invalid email address .*")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_BatchGet(chk *C) {
+	var chunks [][]string
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/users/batch")
+		var body struct {
+			UserIDs []string `json:"user_ids"`
+		}
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		chunks = append(chunks, body.UserIDs)
+		var profiles, missing []string
+		for _, id := range body.UserIDs {
+			if strings.HasPrefix(id, "gone") {
+				missing = append(missing, fmt.Sprintf("%q", id))
+				continue
+			}
+			profiles = append(profiles, fmt.Sprintf(`{"user_id":%q,"display_name":"User %s"}`, id, id))
+		}
+		fmt.Fprintf(w, `{"code":0,"result":{"profiles":[%s],"not_found":[%s]}}`,
+			strings.Join(profiles, ","), strings.Join(missing, ","))
+	})
+	defer ts.Close()
+
+	var ids []string
+	for i := 0; i < MaxBatchGet+10; i++ {
+		ids = append(ids, fmt.Sprintf("u%d", i))
+	}
+	ids = append(ids, "gone1", "u5", "gone2", "u5")
+
+	res, err := svc.User.BatchGet(ids).Do()
+	chk.Assert(err, IsNil)
+	chk.Assert(chunks, HasLen, 2)
+	chk.Check(chunks[0], HasLen, MaxBatchGet)
+	chk.Check(chunks[1], DeepEquals, []string{"u100", "u101", "u102", "u103", "u104", "u105", "u106", "u107", "u108", "u109", "gone1", "gone2"})
+	chk.Check(res.Result.Profiles, HasLen, MaxBatchGet+10)
+	chk.Check(res.Result.Profiles["u5"].DisplayName, Equals, "User u5")
+	chk.Check(res.Result.Profiles["u105"].DisplayName, Equals, "User u105")
+	chk.Check(res.Result.NotFound, DeepEquals, []string{"gone1", "gone2"})
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_Exists(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "HEAD")
+		switch r.URL.Path {
+		case "/v1.1/users/u1":
+			w.WriteHeader(http.StatusOK)
+		case "/v1.1/users/u2":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer ts.Close()
+
+	ok, err := svc.User.Exists("u1").Do()
+	chk.Check(err, IsNil)
+	chk.Check(ok, Equals, true)
+
+	ok, err = svc.User.Exists("u2").Do()
+	chk.Check(err, IsNil)
+	chk.Check(ok, Equals, false)
+
+	ok, err = svc.User.Exists("u3").Do()
+	chk.Check(err, NotNil)
+	chk.Check(ok, Equals, false)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_GetRelationship(chk *C) {
+	relationships := map[string]string{
+		"u1": `{"status":"friend"}`,
+		"u2": `{"status":"pending_sent","invitation_id":"inv-2"}`,
+		"u3": `{"status":"pending_received","invitation_id":"inv-3"}`,
+		"u4": `{"status":"blocked"}`,
+		"u5": `{"status":"none"}`,
+	}
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Path[len("/v1.1/users/"):]
+		if r.URL.Query().Get("include") != "relationship" || id == "private" {
+			fmt.Fprintf(w, `{"code":0,"result":{"user_id":%q}}`, id)
+			return
+		}
+		fmt.Fprintf(w, `{"code":0,"result":{"user_id":%q,"relationship":%s}}`, id, relationships[id])
+	})
+	defer ts.Close()
+
+	for id, want := range map[string]UserRelationship{
+		"u1": {Status: RelationshipFriend},
+		"u2": {Status: RelationshipPendingSent, InvitationID: "inv-2"},
+		"u3": {Status: RelationshipPendingReceived, InvitationID: "inv-3"},
+		"u4": {Status: RelationshipBlocked},
+		"u5": {Status: RelationshipNone},
+	} {
+		res, err := svc.User.Get(id).IncludeRelationship().Do()
+		chk.Assert(err, IsNil)
+		chk.Assert(res.Result.Relationship, NotNil)
+		chk.Check(*res.Result.Relationship, Equals, want)
+	}
+
+	res, err := svc.User.Get("u1").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Relationship, IsNil)
+
+	res, err = svc.User.Get("private").IncludeRelationship().Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.Relationship, IsNil)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_GetByQID(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "GET")
+		if r.URL.Path == "/v1.1/users/qid/Q1234567" {
+			fmt.Fprint(w, `{"code":0,"result":{"user_id":"5f1b7c1e-0c3a-4a8e-9d7f-2b6a1c0e9f11","display_name":"Qeek"}}`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"code":40400,"message":"no such QID"}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.User.GetByQID("Q1234567").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.DisplayName, Equals, "Qeek")
+
+	_, err = svc.User.GetByQID("Q7654321").Do()
+	chk.Check(errors.Is(err, ErrNotFound), Equals, true)
+
+	for _, qid := range []string{"", "1234567", "Q12", "q1234567", "Q1234567/../x"} {
+		_, err = svc.User.GetByQID(qid).Do()
+		chk.Check(err, ErrorMatches, "account: malformed QID .*")
+		chk.Check(errors.Is(err, ErrNotFound), Equals, false)
+	}
+}
@@ -0,0 +1,90 @@
+package account
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"unicode/utf8"
+)
+
+const codeAlreadyReported = 40913
+
+// ReportReason is the category of a user report.
+type ReportReason string
+
+const (
+	ReportSpam          ReportReason = "spam"
+	ReportHarassment    ReportReason = "harassment"
+	ReportImpersonation ReportReason = "impersonation"
+	ReportInappropriate ReportReason = "inappropriate_content"
+	ReportOther         ReportReason = "other"
+)
+
+// IsKnown reports whether r is one of the documented reasons.
+func (r ReportReason) IsKnown() bool {
+	switch r {
+	case ReportSpam, ReportHarassment, ReportImpersonation, ReportInappropriate, ReportOther:
+		return true
+	}
+	return false
+}
+
+// maxReportDetails is the longest details text accepted by Report, in
+// characters.
+const maxReportDetails = 1000
+
+type UserReportResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Result  struct {
+		// CaseID identifies the report for follow-up with support.
+		CaseID string `json:"case_id"`
+	} `json:"result"`
+
+	// AlreadyReported is set when the account reported the user already;
+	// CaseID is then the one of the earlier report, if the API gave it.
+	AlreadyReported bool `json:"-"`
+}
+
+type UserReportCall struct {
+	s       *Service
+	id      string
+	reason  ReportReason
+	details string
+}
+
+// Report reports a user for abuse. details, of at most 1000 characters,
+// describes the problem and may be empty.
+func (r *UserService) Report(userID string, reason ReportReason, details string) *UserReportCall {
+	c := &UserReportCall{s: r.s, id: userID, reason: reason, details: details}
+	return c
+}
+
+func (c *UserReportCall) Do() (*UserReportResponse, error) {
+	if !c.reason.IsKnown() {
+		return nil, fmt.Errorf("account: invalid report reason %q", c.reason)
+	}
+	if utf8.RuneCountInString(c.details) > maxReportDetails {
+		return nil, fmt.Errorf("account: report details must be at most %d characters long", maxReportDetails)
+	}
+	path := versioned("users/" + url.PathEscape(c.id) + "/reports")
+	payload := struct {
+		Reason  ReportReason `json:"reason"`
+		Details string       `json:"details,omitempty"`
+	}{c.reason, c.details}
+	ret := &UserReportResponse{}
+	_, err := c.s.post(path, payload, ret)
+	if err != nil {
+		var e *ErrorResponse
+		if errors.As(err, &e) && e.Code == codeAlreadyReported {
+			ret = &UserReportResponse{Message: e.Message, Code: e.Code, AlreadyReported: true}
+			if len(e.Details) > 0 {
+				json.Unmarshal(e.Details, &ret.Result)
+			}
+			return ret, nil
+		}
+		return nil, err
+	}
+	return ret, nil
+}
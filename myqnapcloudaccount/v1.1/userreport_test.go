@@ -0,0 +1,51 @@
+package account
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_Report(chk *C) {
+	reported := false
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Check(r.Method, Equals, "POST")
+		chk.Check(r.URL.Path, Equals, "/v1.1/users/u9/reports")
+		var body map[string]string
+		chk.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		chk.Check(body, DeepEquals, map[string]string{"reason": "harassment", "details": "Repeated invitations"})
+		if reported {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"code":40913,"message":"already reported","details":{"case_id":"CASE-1001"}}`)
+			return
+		}
+		reported = true
+		fmt.Fprint(w, `{"code":0,"result":{"case_id":"CASE-1001"}}`)
+	})
+	defer ts.Close()
+
+	res, err := svc.User.Report("u9", ReportHarassment, "Repeated invitations").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.Result.CaseID, Equals, "CASE-1001")
+	chk.Check(res.AlreadyReported, Equals, false)
+
+	res, err = svc.User.Report("u9", ReportHarassment, "Repeated invitations").Do()
+	chk.Assert(err, IsNil)
+	chk.Check(res.AlreadyReported, Equals, true)
+	chk.Check(res.Result.CaseID, Equals, "CASE-1001")
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_User_ReportValidation(chk *C) {
+	svc, ts := newFakeService(func(w http.ResponseWriter, r *http.Request) {
+		chk.Error("unexpected request")
+	})
+	defer ts.Close()
+
+	_, err := svc.User.Report("u9", "rude", "").Do()
+	chk.Check(err, ErrorMatches, `account: invalid report reason "rude"`)
+	_, err = svc.User.Report("u9", ReportSpam, strings.Repeat("x", 1001)).Do()
+	chk.Check(err, ErrorMatches, "account: report details must be at most 1000 characters long")
+}
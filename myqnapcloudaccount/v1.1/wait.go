@@ -0,0 +1,49 @@
+package account
+
+import (
+	"context"
+	"time"
+)
+
+const defaultWaitInterval = 2 * time.Second
+
+type waitConfig struct {
+	interval time.Duration
+}
+
+// A WaitOption configures WaitFor.
+type WaitOption func(*waitConfig)
+
+// WaitInterval sets the delay between two evaluations of the condition.
+func WaitInterval(d time.Duration) WaitOption {
+	return func(c *waitConfig) {
+		if d > 0 {
+			c.interval = d
+		}
+	}
+}
+
+// WaitFor evaluates cond until it reports done, returns an error, or ctx
+// is done. The condition is evaluated once immediately.
+func WaitFor(ctx context.Context, cond func() (done bool, err error), opts ...WaitOption) error {
+	cfg := &waitConfig{interval: defaultWaitInterval}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	t := time.NewTimer(0)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+
+		done, err := cond()
+		if err != nil || done {
+			return err
+		}
+		t.Reset(cfg.interval)
+	}
+}
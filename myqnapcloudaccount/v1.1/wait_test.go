@@ -0,0 +1,35 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) Test_Myqnapcloud_Account_WaitFor(chk *C) {
+	n := 0
+	err := WaitFor(context.Background(), func() (bool, error) {
+		n++
+		return n == 3, nil
+	}, WaitInterval(time.Millisecond))
+	chk.Check(err, IsNil)
+	chk.Check(n, Equals, 3)
+
+	boom := errors.New("boom")
+	err = WaitFor(context.Background(), func() (bool, error) {
+		return false, boom
+	}, WaitInterval(time.Millisecond))
+	chk.Check(err, Equals, boom)
+}
+
+func (s *MySuite) Test_Myqnapcloud_Account_WaitFor_Context(chk *C) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := WaitFor(ctx, func() (bool, error) {
+		return false, nil
+	}, WaitInterval(time.Millisecond))
+	chk.Check(err, Equals, context.DeadlineExceeded)
+}